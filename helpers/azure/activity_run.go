@@ -0,0 +1,32 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package azure
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// FlattenActivityRunError returns the message of an Activity run error, which is returned from the Data Factory and
+// Synapse APIs as an untyped object
+func FlattenActivityRunError(input *interface{}) string {
+	if input == nil || *input == nil {
+		return "no error details were returned"
+	}
+
+	if v, ok := (*input).(map[string]interface{}); ok {
+		if message, ok := v["message"].(string); ok && message != "" {
+			if code, ok := v["errorCode"].(string); ok && code != "" {
+				return fmt.Sprintf("%s: %s", code, message)
+			}
+			return message
+		}
+	}
+
+	b, err := json.Marshal(*input)
+	if err != nil {
+		return fmt.Sprintf("%+v", *input)
+	}
+	return string(b)
+}
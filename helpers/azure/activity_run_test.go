@@ -0,0 +1,52 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package azure_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+)
+
+func TestFlattenActivityRunError(t *testing.T) {
+	cases := []struct {
+		Input    interface{}
+		Expected string
+	}{
+		{
+			Input:    nil,
+			Expected: "no error details were returned",
+		},
+		{
+			Input: map[string]interface{}{
+				"errorCode": "2011",
+				"message":   "The activity failed",
+			},
+			Expected: "2011: The activity failed",
+		},
+		{
+			Input: map[string]interface{}{
+				"message": "The activity failed",
+			},
+			Expected: "The activity failed",
+		},
+		{
+			Input: map[string]interface{}{
+				"errorCode": "2011",
+				"message":   "",
+			},
+			Expected: `{"errorCode":"2011","message":""}`,
+		},
+	}
+
+	for _, tc := range cases {
+		if actual := azure.FlattenActivityRunError(&tc.Input); actual != tc.Expected {
+			t.Fatalf("expected %q but got %q", tc.Expected, actual)
+		}
+	}
+
+	if actual := azure.FlattenActivityRunError(nil); actual != "no error details were returned" {
+		t.Fatalf("expected a nil input to return the default message but got %q", actual)
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/vcr"
 )
 
@@ -27,8 +28,11 @@ type ClientBuilder struct {
 	Features   features.UserFeatures
 
 	CustomCorrelationRequestID  string
+	DefaultTags                 map[string]string
 	DisableCorrelationRequestID bool
 	DisableTerraformPartnerID   bool
+	IgnoreTags                  tags.IgnoreConfig
+	MaxRequestsPerSecond        int
 	MetadataHost                string
 	PartnerID                   string
 	RegisteredResourceProviders resourceproviders.ResourceProviders
@@ -36,6 +40,7 @@ type ClientBuilder struct {
 	SubscriptionID              string
 	TerraformVersion            string
 	TestName                    string
+	ThrottlingRetry             bool
 }
 
 const azureStackEnvironmentError = `
@@ -117,7 +122,9 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 	}
 
 	client := Client{
-		Account: account,
+		Account:     account,
+		DefaultTags: builder.DefaultTags,
+		IgnoreTags:  builder.IgnoreTags,
 	}
 
 	o := &common.ClientOptions{
@@ -154,6 +161,12 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		ResourceManagerEndpoint: *resourceManagerEndpoint,
 	}
 
+	if builder.MaxRequestsPerSecond > 0 || builder.ThrottlingRetry {
+		if o.RequestScheduler, err = common.NewRequestScheduler(*resourceManagerEndpoint, account.TenantId, builder.MaxRequestsPerSecond, builder.ThrottlingRetry); err != nil {
+			return nil, fmt.Errorf("building Request Scheduler: %+v", err)
+		}
+	}
+
 	// go-vcr integration
 	// TC_TEST_VIA_VCR can be set to `true` or `record` see the testing guides for more information
 	if os.Getenv("TC_TEST_VIA_VCR") != "" && builder.TestName != "" {
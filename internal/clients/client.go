@@ -147,6 +147,7 @@ import (
 	vmware "github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/client"
 	web "github.com/hashicorp/terraform-provider-azurerm/internal/services/web/client"
 	workloads "github.com/hashicorp/terraform-provider-azurerm/internal/services/workloads/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 )
 
 type Client struct {
@@ -158,6 +159,17 @@ type Client struct {
 	Account  *ResourceManagerAccount
 	Features features.UserFeatures
 
+	// DefaultTags are the tags defined in the `default_tags` field of the Provider block, which are assigned to
+	// every Resource supporting tags
+	DefaultTags map[string]string
+
+	// IgnoreTags are the tags defined in the `ignore_tags` block of the Provider block, which are managed outside of
+	// Terraform and are omitted when flattening the tags assigned to a Resource or Data Source
+	IgnoreTags tags.IgnoreConfig
+
+	// AuthorizerFunc builds an Authorizer for an arbitrary API using the credentials the Provider is authenticated with
+	AuthorizerFunc common.ApiAuthorizerFunc
+
 	Preflight *preflight.Client
 
 	AadB2c                            *aadb2c_v2021_04_01_preview.Client
@@ -296,6 +308,7 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 		return fmt.Errorf("building auto-clients: %+v", err)
 	}
 
+	client.AuthorizerFunc = o.Authorizers.AuthorizerFunc
 	client.Features = o.Features
 	client.StopContext = ctx
 
@@ -63,6 +63,10 @@ type ClientOptions struct {
 	// Transport exposes the go-azure-sdk mechanism to attach / replace the default transport. Primarily for go-vcr
 	// testing
 	Transport http.RoundTripper
+
+	// RequestScheduler, when set, is shared by all clients to rate limit requests and to delay requests when
+	// Azure reports that requests are being (or are about to be) throttled
+	RequestScheduler *RequestScheduler
 }
 
 // Configure set up a resourcemanager.Client using an auth.Authorizer from hashicorp/go-azure-sdk
@@ -70,7 +74,9 @@ func (o ClientOptions) Configure(c client.BaseClient, authorizer auth.Authorizer
 	c.SetAuthorizer(authorizer)
 	c.SetUserAgent(userAgent(c.GetUserAgent(), o.TerraformVersion, o.PartnerId, o.DisableTerraformPartnerID))
 
-	if o.Transport != nil {
+	if o.RequestScheduler != nil {
+		c.SetTransport(o.RequestScheduler.Transport(o.Transport))
+	} else if o.Transport != nil {
 		c.SetTransport(o.Transport)
 	}
 
@@ -92,6 +98,9 @@ func (o ClientOptions) ConfigureClient(c *autorest.Client, authorizer autorest.A
 
 	c.Authorizer = authorizer
 	c.Sender = sender.BuildSender("AzureRM")
+	if o.RequestScheduler != nil {
+		c.Sender = o.RequestScheduler.Sender(c.Sender)
+	}
 	c.SkipResourceProviderRegistration = o.SkipProviderReg
 	if !o.DisableCorrelationRequestID {
 		id := o.CustomCorrelationRequestID
@@ -0,0 +1,339 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+const (
+	// lowRemainingRequests is the number of remaining requests reported by Azure, below which
+	// requests within the same scope are slowed down to avoid being throttled
+	lowRemainingRequests = 10
+
+	// lowRemainingRequestsDelay is the delay added for each request below lowRemainingRequests
+	// which remains, e.g. with 4 requests remaining subsequent requests are delayed by 6x this value
+	lowRemainingRequestsDelay = 200 * time.Millisecond
+
+	// defaultThrottlingRetryAfter is used when a throttled response doesn't include a valid `Retry-After` header
+	defaultThrottlingRetryAfter = 10 * time.Second
+)
+
+// RequestScheduler is shared by each of the clients used by the Provider, so that requests made by every client
+// are subject to the same rate limit and throttling, rather than each client retrying independently.
+type RequestScheduler struct {
+	// limiter limits the rate of requests across all clients, and is nil when requests aren't rate limited
+	limiter *tokenBucket
+
+	// throttlingRetry specifies whether requests should be delayed based on the rate limits reported by Azure
+	throttlingRetry bool
+
+	// resourceManagerHost is the host of the Resource Manager API, the rate limits of which are tracked
+	resourceManagerHost string
+
+	tenantId string
+
+	// transport is used for clients which don't specify a custom transport, so that connections are shared
+	transport *http.Transport
+
+	lock sync.Mutex
+	// pausedUntil is the time until which requests to each scope (a Subscription or Tenant for a class of request)
+	// are delayed, either due to the remaining rate limit being low or the requests being throttled
+	pausedUntil map[throttlingScope]time.Time
+}
+
+// NewRequestScheduler returns a RequestScheduler limited to maxRequestsPerSecond (when greater than zero), which
+// delays requests to Resource Manager based on the rate limit headers returned by Azure when throttlingRetry is enabled
+func NewRequestScheduler(resourceManagerEndpoint string, tenantId string, maxRequestsPerSecond int, throttlingRetry bool) (*RequestScheduler, error) {
+	endpoint, err := url.Parse(resourceManagerEndpoint)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Resource Manager endpoint %q: %+v", resourceManagerEndpoint, err)
+	}
+
+	scheduler := &RequestScheduler{
+		throttlingRetry:     throttlingRetry,
+		resourceManagerHost: strings.ToLower(endpoint.Host),
+		tenantId:            strings.ToLower(tenantId),
+		transport:           defaultTransport(),
+		pausedUntil:         make(map[throttlingScope]time.Time),
+	}
+
+	if maxRequestsPerSecond > 0 {
+		scheduler.limiter = newTokenBucket(maxRequestsPerSecond)
+	}
+
+	return scheduler, nil
+}
+
+// throttlingScope is the scope of Azure's rate limits, which are tracked per Subscription and Tenant for each class
+// of request (reads, writes and deletes)
+type throttlingScope struct {
+	// kind is either `subscription` or `tenant`, matching the `x-ms-ratelimit-remaining-{kind}-{class}` headers
+	kind  string
+	id    string
+	class string
+}
+
+// Transport returns a http.RoundTripper which schedules each request (including retries) before sending it using
+// the base http.RoundTripper, or a default transport when base is nil
+func (s *RequestScheduler) Transport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = s.transport
+	}
+
+	return scheduledTransport{
+		scheduler: s,
+		base:      base,
+	}
+}
+
+// Sender returns an autorest.Sender which schedules each request before sending it using the base autorest.Sender
+func (s *RequestScheduler) Sender(base autorest.Sender) autorest.Sender {
+	return autorest.SenderFunc(func(request *http.Request) (*http.Response, error) {
+		return s.do(request, base.Do)
+	})
+}
+
+type scheduledTransport struct {
+	scheduler *RequestScheduler
+	base      http.RoundTripper
+}
+
+func (t scheduledTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	return t.scheduler.do(request, t.base.RoundTrip)
+}
+
+func (s *RequestScheduler) do(request *http.Request, send func(*http.Request) (*http.Response, error)) (*http.Response, error) {
+	scopes := s.scopesForRequest(request)
+
+	if err := s.wait(request.Context(), scopes); err != nil {
+		return nil, err
+	}
+
+	response, err := send(request)
+	if err == nil && response != nil {
+		s.observe(request, scopes, response)
+	}
+
+	return response, err
+}
+
+// wait blocks until the request can be sent, or the context is cancelled
+func (s *RequestScheduler) wait(ctx context.Context, scopes []throttlingScope) error {
+	if s.throttlingRetry {
+		for {
+			s.lock.Lock()
+			until := time.Time{}
+			for _, scope := range scopes {
+				if v := s.pausedUntil[scope]; v.After(until) {
+					until = v
+				}
+			}
+			s.lock.Unlock()
+
+			delay := time.Until(until)
+			if delay <= 0 {
+				break
+			}
+
+			log.Printf("[DEBUG] Delaying request by %s due to Azure rate limits", delay.Round(time.Millisecond))
+			if err := sleepWithContext(ctx, delay); err != nil {
+				return fmt.Errorf("waiting for Azure rate limits: %+v", err)
+			}
+		}
+	}
+
+	if s.limiter != nil {
+		if err := s.limiter.wait(ctx); err != nil {
+			return fmt.Errorf("waiting for `max_requests_per_second`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+// observe updates the scopes using the rate limit headers returned from Azure, pausing requests to the scope when
+// the request was throttled or slowing them down when the remaining requests are low
+func (s *RequestScheduler) observe(request *http.Request, scopes []throttlingScope, response *http.Response) {
+	if !s.throttlingRetry {
+		return
+	}
+
+	now := time.Now()
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for _, scope := range scopes {
+		header := fmt.Sprintf("x-ms-ratelimit-remaining-%s-%s", scope.kind, scope.class)
+		remaining, err := strconv.Atoi(response.Header.Get(header))
+		if err != nil || remaining >= lowRemainingRequests {
+			continue
+		}
+
+		delay := time.Duration(lowRemainingRequests-remaining) * lowRemainingRequestsDelay
+		s.pauseLocked(scope, now.Add(delay))
+	}
+
+	if response.StatusCode == http.StatusTooManyRequests {
+		retryAfter := parseRetryAfter(response.Header.Get("Retry-After"), now)
+		log.Printf("[DEBUG] Request to %s was throttled, pausing requests for %s", request.URL.Host, retryAfter)
+
+		// the throttled scope isn't reported, so the most specific scope for the request is paused
+		if len(scopes) > 0 {
+			s.pauseLocked(scopes[0], now.Add(retryAfter))
+		}
+	}
+}
+
+// pauseLocked pauses requests to the scope until the specified time, the caller must hold the lock
+func (s *RequestScheduler) pauseLocked(scope throttlingScope, until time.Time) {
+	if until.After(s.pausedUntil[scope]) {
+		s.pausedUntil[scope] = until
+	}
+}
+
+// scopesForRequest returns the scopes for the request, ordered from the most to least specific. Only the rate
+// limits for Resource Manager are tracked, so requests to other APIs (e.g. data plane APIs) have no scopes.
+func (s *RequestScheduler) scopesForRequest(request *http.Request) []throttlingScope {
+	scopes := make([]throttlingScope, 0)
+	if !strings.EqualFold(request.URL.Host, s.resourceManagerHost) {
+		return scopes
+	}
+
+	class := "writes"
+	switch request.Method {
+	case http.MethodGet, http.MethodHead:
+		class = "reads"
+	case http.MethodDelete:
+		class = "deletes"
+	}
+
+	if subscriptionId := subscriptionIdFromPath(request.URL.Path); subscriptionId != "" {
+		scopes = append(scopes, throttlingScope{
+			kind:  "subscription",
+			id:    subscriptionId,
+			class: class,
+		})
+	}
+	if s.tenantId != "" {
+		scopes = append(scopes, throttlingScope{
+			kind:  "tenant",
+			id:    s.tenantId,
+			class: class,
+		})
+	}
+
+	return scopes
+}
+
+func subscriptionIdFromPath(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i := 0; i < len(segments)-1; i++ {
+		if strings.EqualFold(segments[i], "subscriptions") {
+			return strings.ToLower(segments[i+1])
+		}
+	}
+
+	return ""
+}
+
+// parseRetryAfter parses the `Retry-After` header, which is either a number of seconds or a HTTP date
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(value); err == nil && date.After(now) {
+		return date.Sub(now)
+	}
+
+	return defaultThrottlingRetryAfter
+}
+
+func sleepWithContext(ctx context.Context, duration time.Duration) error {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// tokenBucket is a token bucket rate limiter, holding at most one second's worth of tokens
+type tokenBucket struct {
+	lock     sync.Mutex
+	rate     float64
+	capacity float64
+	tokens   float64
+	last     time.Time
+}
+
+func newTokenBucket(requestsPerSecond int) *tokenBucket {
+	return &tokenBucket{
+		rate:     float64(requestsPerSecond),
+		capacity: float64(requestsPerSecond),
+		tokens:   float64(requestsPerSecond),
+		last:     time.Now(),
+	}
+}
+
+// wait blocks until a token is available, or the context is cancelled
+func (b *tokenBucket) wait(ctx context.Context) error {
+	b.lock.Lock()
+	now := time.Now()
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if b.tokens > b.capacity {
+		b.tokens = b.capacity
+	}
+	b.last = now
+
+	// the token is reserved immediately, so callers are served in the order they arrive
+	b.tokens--
+	delay := time.Duration(0)
+	if b.tokens < 0 {
+		delay = time.Duration(-b.tokens / b.rate * float64(time.Second))
+	}
+	b.lock.Unlock()
+
+	if delay == 0 {
+		return nil
+	}
+
+	if err := sleepWithContext(ctx, delay); err != nil {
+		// return the reserved token
+		b.lock.Lock()
+		b.tokens++
+		b.lock.Unlock()
+		return err
+	}
+
+	return nil
+}
+
+// defaultTransport returns a http.Transport matching the default used by go-azure-sdk, which is used when the
+// scheduler is installed without a custom transport
+func defaultTransport() *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = http.ProxyFromEnvironment
+	transport.TLSClientConfig = &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+	transport.ForceAttemptHTTP2 = true
+	return transport
+}
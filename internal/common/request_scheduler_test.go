@@ -0,0 +1,231 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(request *http.Request) (*http.Response, error) {
+	return f(request)
+}
+
+func newTestRequestScheduler(t *testing.T, maxRequestsPerSecond int, throttlingRetry bool) *RequestScheduler {
+	scheduler, err := NewRequestScheduler("https://management.azure.com/", "00000000-0000-0000-0000-000000000000", maxRequestsPerSecond, throttlingRetry)
+	if err != nil {
+		t.Fatalf("building Request Scheduler: %+v", err)
+	}
+	return scheduler
+}
+
+func TestRequestSchedulerScopesForRequest(t *testing.T) {
+	scheduler := newTestRequestScheduler(t, 0, true)
+
+	cases := []struct {
+		Method   string
+		URL      string
+		Expected []throttlingScope
+	}{
+		{
+			Method: http.MethodGet,
+			URL:    "https://management.azure.com/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example?api-version=2020-01-01",
+			Expected: []throttlingScope{
+				{kind: "subscription", id: "11111111-1111-1111-1111-111111111111", class: "reads"},
+				{kind: "tenant", id: "00000000-0000-0000-0000-000000000000", class: "reads"},
+			},
+		},
+		{
+			Method: http.MethodPut,
+			URL:    "https://MANAGEMENT.azure.com/Subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example",
+			Expected: []throttlingScope{
+				{kind: "subscription", id: "11111111-1111-1111-1111-111111111111", class: "writes"},
+				{kind: "tenant", id: "00000000-0000-0000-0000-000000000000", class: "writes"},
+			},
+		},
+		{
+			Method: http.MethodDelete,
+			URL:    "https://management.azure.com/providers/Microsoft.Management/managementGroups/example",
+			Expected: []throttlingScope{
+				{kind: "tenant", id: "00000000-0000-0000-0000-000000000000", class: "deletes"},
+			},
+		},
+		{
+			Method:   http.MethodGet,
+			URL:      "https://example.blob.core.windows.net/container/subscriptions/blob",
+			Expected: []throttlingScope{},
+		},
+	}
+
+	for _, tc := range cases {
+		request, err := http.NewRequest(tc.Method, tc.URL, nil)
+		if err != nil {
+			t.Fatalf("building request: %+v", err)
+		}
+
+		actual := scheduler.scopesForRequest(request)
+		if len(actual) != len(tc.Expected) {
+			t.Fatalf("expected %d scopes for %s %s but got %d: %+v", len(tc.Expected), tc.Method, tc.URL, len(actual), actual)
+		}
+		for i := range actual {
+			if actual[i] != tc.Expected[i] {
+				t.Fatalf("expected scope %d for %s %s to be %+v but got %+v", i, tc.Method, tc.URL, tc.Expected[i], actual[i])
+			}
+		}
+	}
+}
+
+func TestRequestSchedulerPausesWhenThrottled(t *testing.T) {
+	scheduler := newTestRequestScheduler(t, 0, true)
+
+	sent := make([]time.Time, 0)
+	transport := scheduler.Transport(roundTripperFunc(func(request *http.Request) (*http.Response, error) {
+		sent = append(sent, time.Now())
+
+		response := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{},
+			Request:    request,
+		}
+		if len(sent) == 1 {
+			response.StatusCode = http.StatusTooManyRequests
+			response.Header.Set("Retry-After", "1")
+		}
+		return response, nil
+	}))
+
+	for i := 0; i < 2; i++ {
+		request, err := http.NewRequest(http.MethodGet, "https://management.azure.com/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example", nil)
+		if err != nil {
+			t.Fatalf("building request: %+v", err)
+		}
+		if _, err := transport.RoundTrip(request); err != nil {
+			t.Fatalf("sending request: %+v", err)
+		}
+	}
+
+	if delay := sent[1].Sub(sent[0]); delay < 900*time.Millisecond {
+		t.Fatalf("expected the second request to be delayed by the `Retry-After` header, but was sent after %s", delay)
+	}
+
+	// other classes of request aren't paused
+	request, err := http.NewRequest(http.MethodPut, "https://management.azure.com/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example", nil)
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if err := scheduler.wait(ctx, scheduler.scopesForRequest(request)); err != nil {
+		t.Fatalf("expected writes not to be paused, got %+v", err)
+	}
+}
+
+func TestRequestSchedulerSlowsDownWhenRemainingRequestsAreLow(t *testing.T) {
+	scheduler := newTestRequestScheduler(t, 0, true)
+
+	request, err := http.NewRequest(http.MethodGet, "https://management.azure.com/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example", nil)
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+	scopes := scheduler.scopesForRequest(request)
+
+	response := &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{},
+	}
+	response.Header.Set("x-ms-ratelimit-remaining-subscription-reads", "8")
+	scheduler.observe(request, scopes, response)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if err := scheduler.wait(ctx, scopes); err == nil {
+		t.Fatalf("expected the request to be delayed whilst the remaining requests are low")
+	}
+
+	if err := scheduler.wait(context.Background(), scopes); err != nil {
+		t.Fatalf("expected the request to be sent once the delay has elapsed, got %+v", err)
+	}
+}
+
+func TestRequestSchedulerIgnoresHeadersWithoutThrottlingRetry(t *testing.T) {
+	scheduler := newTestRequestScheduler(t, 0, false)
+
+	request, err := http.NewRequest(http.MethodGet, "https://management.azure.com/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example", nil)
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+	scopes := scheduler.scopesForRequest(request)
+
+	response := &http.Response{
+		StatusCode: http.StatusTooManyRequests,
+		Header:     http.Header{},
+	}
+	response.Header.Set("Retry-After", "60")
+	scheduler.observe(request, scopes, response)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if err := scheduler.wait(ctx, scopes); err != nil {
+		t.Fatalf("expected the request not to be delayed, got %+v", err)
+	}
+}
+
+func TestTokenBucket(t *testing.T) {
+	bucket := newTokenBucket(10)
+	ctx := context.Background()
+
+	start := time.Now()
+	for i := 0; i < 15; i++ {
+		if err := bucket.wait(ctx); err != nil {
+			t.Fatalf("waiting for token: %+v", err)
+		}
+	}
+
+	// the first 10 requests are allowed immediately, with the remaining 5 at 10 per second
+	if elapsed := time.Since(start); elapsed < 450*time.Millisecond {
+		t.Fatalf("expected the requests to be rate limited, but took %s", elapsed)
+	}
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	if err := bucket.wait(cancelled); err == nil {
+		t.Fatalf("expected an error when the context is cancelled")
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		Input    string
+		Expected time.Duration
+	}{
+		{
+			Input:    "30",
+			Expected: 30 * time.Second,
+		},
+		{
+			Input:    now.Add(2 * time.Minute).Format(http.TimeFormat),
+			Expected: 2 * time.Minute,
+		},
+		{
+			Input:    "",
+			Expected: defaultThrottlingRetryAfter,
+		},
+		{
+			Input:    "invalid",
+			Expected: defaultThrottlingRetryAfter,
+		},
+	}
+
+	for _, tc := range cases {
+		if actual := parseRetryAfter(tc.Input, now); actual != tc.Expected {
+			t.Fatalf("expected %q to be parsed as %s but got %s", tc.Input, tc.Expected, actual)
+		}
+	}
+}
@@ -3,7 +3,10 @@
 
 package locks
 
-import "slices"
+import (
+	"context"
+	"slices"
+)
 
 // armMutexKV is the instance of MutexKV for ARM resources
 var armMutexKV = newMutexKV()
@@ -12,12 +15,32 @@ func ByID(id string) {
 	armMutexKV.Lock(id)
 }
 
+// LockWithContext locks the resource by ID, returning an error which includes the operation holding
+// the lock if it can't be acquired before the context is cancelled or times out
+func LockWithContext(ctx context.Context, id string) error {
+	return armMutexKV.LockWithContext(ctx, id)
+}
+
 // handle the case of using the same name for different kinds of resources
 func ByName(name string, resourceType string) {
 	updatedName := resourceType + "." + name
 	armMutexKV.Lock(updatedName)
 }
 
+// ByNameWithContext locks the resource by name, returning an error which includes the operation holding
+// the lock if it can't be acquired before the context is cancelled or times out
+func ByNameWithContext(ctx context.Context, name string, resourceType string) error {
+	updatedName := resourceType + "." + name
+	return armMutexKV.LockWithContext(ctx, updatedName)
+}
+
+// ReadByIDWithContext takes a read lock on the resource by ID, which can be held concurrently with other
+// read locks but not with a lock taken using ByID, returning an error which includes the operation holding
+// the lock if it can't be acquired before the context is cancelled or times out
+func ReadByIDWithContext(ctx context.Context, id string) error {
+	return armMutexKV.RLockWithContext(ctx, id)
+}
+
 func MultipleByID(ids *[]string) {
 	newSlice := removeDuplicatesFromStringArray(*ids)
 
@@ -28,6 +51,26 @@ func MultipleByID(ids *[]string) {
 	}
 }
 
+// MultipleByIDWithContext locks each of the resources by ID, returning an error if any of the locks
+// can't be acquired before the context is cancelled or times out - in which case any locks which
+// have already been acquired are released
+func MultipleByIDWithContext(ctx context.Context, ids *[]string) error {
+	newSlice := removeDuplicatesFromStringArray(*ids)
+
+	slices.Sort(newSlice)
+
+	for i, id := range newSlice {
+		if err := LockWithContext(ctx, id); err != nil {
+			for _, lockedId := range newSlice[:i] {
+				UnlockByID(lockedId)
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func MultipleByName(names *[]string, resourceType string) {
 	newSlice := removeDuplicatesFromStringArray(*names)
 
@@ -38,6 +81,26 @@ func MultipleByName(names *[]string, resourceType string) {
 	}
 }
 
+// MultipleByNameWithContext locks each of the resources by name, returning an error if any of the
+// locks can't be acquired before the context is cancelled or times out - in which case any locks
+// which have already been acquired are released
+func MultipleByNameWithContext(ctx context.Context, names *[]string, resourceType string) error {
+	newSlice := removeDuplicatesFromStringArray(*names)
+
+	slices.Sort(newSlice)
+
+	for i, name := range newSlice {
+		if err := ByNameWithContext(ctx, name, resourceType); err != nil {
+			for _, lockedName := range newSlice[:i] {
+				UnlockByName(lockedName, resourceType)
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func UnlockByID(id string) {
 	armMutexKV.Unlock(id)
 }
@@ -47,6 +110,11 @@ func UnlockByName(name string, resourceType string) {
 	armMutexKV.Unlock(updatedName)
 }
 
+// UnlockReadByID releases a read lock taken using ReadByIDWithContext
+func UnlockReadByID(id string) {
+	armMutexKV.RUnlock(id)
+}
+
 func UnlockMultipleByID(ids *[]string) {
 	newSlice := removeDuplicatesFromStringArray(*ids)
 
@@ -4,48 +4,253 @@
 package locks
 
 import (
+	"context"
+	"fmt"
 	"log"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
 	"sync"
+	"time"
 )
 
-// mutexKV is a simple key/value store for arbitrary mutexes. It can be used to
+// locksPackageDir is the source directory of this package, used to determine the operation holding a lock
+var locksPackageDir = func() string {
+	_, file, _, ok := runtime.Caller(0)
+	if !ok {
+		return ""
+	}
+	return filepath.Dir(file)
+}()
+
+// mutexKV is a simple key/value store for arbitrary read/write locks. It can be used to
 // serialize changes across arbitrary collaborators that share knowledge of the
 // keys they must serialize on.
 type mutexKV struct {
 	lock  sync.Mutex
-	store map[string]*sync.Mutex
+	store map[string]*keyLock
+}
+
+// keyLock is the state of the lock for a single key, which is guarded by the lock on the mutexKV
+type keyLock struct {
+	// writer is the holder of the write lock, or nil when the key isn't write-locked
+	writer *lockHolder
+
+	// readers are the holders of read locks on the key
+	readers []*lockHolder
+
+	// waitingWriters is the number of callers waiting for the write lock, new read locks aren't
+	// granted whilst writers are waiting so that writes aren't starved by a stream of reads
+	waitingWriters int
+
+	// released is closed (and replaced) whenever the state of the lock changes, to wake any waiting callers
+	released chan struct{}
+}
+
+// lockHolder describes the operation holding a lock, which is included in the error when a lock can't be acquired
+type lockHolder struct {
+	operation string
+	since     time.Time
+}
+
+func (h lockHolder) String() string {
+	return fmt.Sprintf("%s (for %s)", h.operation, time.Since(h.since).Round(time.Second))
 }
 
 // Locks the mutex for the given key. Caller is responsible for calling Unlock
 // for the same key
 func (m *mutexKV) Lock(key string) {
+	// this can't fail since the context is never cancelled
+	_ = m.LockWithContext(context.Background(), key)
+}
+
+// LockWithContext locks the mutex for the given key, returning an error if the context is
+// cancelled or times out first. Caller is responsible for calling Unlock for the same key
+// when this returns no error
+func (m *mutexKV) LockWithContext(ctx context.Context, key string) error {
 	log.Printf("[DEBUG] Locking %q", key)
-	m.get(key).Lock()
+	holder := newLockHolder()
+
+	m.lock.Lock()
+	entry := m.get(key)
+	waiting := false
+	for entry.writer != nil || len(entry.readers) > 0 {
+		if !waiting {
+			entry.waitingWriters++
+			waiting = true
+		}
+		released := entry.released
+		m.lock.Unlock()
+
+		select {
+		case <-released:
+			m.lock.Lock()
+		case <-ctx.Done():
+			m.lock.Lock()
+			entry.waitingWriters--
+			// readers may be waiting on this writer
+			entry.notify()
+			err := fmt.Errorf("waiting for the lock on %q (held by %s): %+v", key, entry.holders(), ctx.Err())
+			m.lock.Unlock()
+			return err
+		}
+	}
+
+	if waiting {
+		entry.waitingWriters--
+	}
+	entry.writer = holder
+	m.lock.Unlock()
+
 	log.Printf("[DEBUG] Locked %q", key)
+	return nil
 }
 
 // Unlock the mutex for the given key. Caller must have called Lock for the same key first
 func (m *mutexKV) Unlock(key string) {
 	log.Printf("[DEBUG] Unlocking %q", key)
-	m.get(key).Unlock()
+
+	m.lock.Lock()
+	entry := m.get(key)
+	if entry.writer == nil {
+		m.lock.Unlock()
+		panic(fmt.Sprintf("unlock of unlocked key %q", key))
+	}
+	entry.writer = nil
+	entry.notify()
+	m.lock.Unlock()
+
 	log.Printf("[DEBUG] Unlocked %q", key)
 }
 
-// Returns a mutex for the given key, no guarantee of its lock status
-func (m *mutexKV) get(key string) *sync.Mutex {
+// RLock takes a read lock for the given key, which can be held by multiple callers at the same
+// time but not whilst the key is locked for writing. Caller is responsible for calling RUnlock
+// for the same key
+func (m *mutexKV) RLock(key string) {
+	// this can't fail since the context is never cancelled
+	_ = m.RLockWithContext(context.Background(), key)
+}
+
+// RLockWithContext takes a read lock for the given key, returning an error if the context is
+// cancelled or times out first. Caller is responsible for calling RUnlock for the same key
+// when this returns no error
+func (m *mutexKV) RLockWithContext(ctx context.Context, key string) error {
+	log.Printf("[DEBUG] Read Locking %q", key)
+	holder := newLockHolder()
+
 	m.lock.Lock()
-	defer m.lock.Unlock()
-	mutex, ok := m.store[key]
+	entry := m.get(key)
+	for entry.writer != nil || entry.waitingWriters > 0 {
+		released := entry.released
+		m.lock.Unlock()
+
+		select {
+		case <-released:
+			m.lock.Lock()
+		case <-ctx.Done():
+			m.lock.Lock()
+			err := fmt.Errorf("waiting for the read lock on %q (held by %s): %+v", key, entry.holders(), ctx.Err())
+			m.lock.Unlock()
+			return err
+		}
+	}
+
+	entry.readers = append(entry.readers, holder)
+	m.lock.Unlock()
+
+	log.Printf("[DEBUG] Read Locked %q", key)
+	return nil
+}
+
+// RUnlock releases a read lock for the given key. Caller must have called RLock for the same key first
+func (m *mutexKV) RUnlock(key string) {
+	log.Printf("[DEBUG] Read Unlocking %q", key)
+
+	m.lock.Lock()
+	entry := m.get(key)
+	if len(entry.readers) == 0 {
+		m.lock.Unlock()
+		panic(fmt.Sprintf("read unlock of key %q which isn't read locked", key))
+	}
+	// read locks are interchangeable, so the earliest holder is released to keep the remaining holders accurate
+	// enough for reporting
+	entry.readers = entry.readers[1:]
+	if len(entry.readers) == 0 {
+		entry.notify()
+	}
+	m.lock.Unlock()
+
+	log.Printf("[DEBUG] Read Unlocked %q", key)
+}
+
+// Returns the lock state for the given key, the caller must hold the lock on the mutexKV
+func (m *mutexKV) get(key string) *keyLock {
+	entry, ok := m.store[key]
 	if !ok {
-		mutex = &sync.Mutex{}
-		m.store[key] = mutex
+		entry = &keyLock{
+			released: make(chan struct{}),
+		}
+		m.store[key] = entry
 	}
-	return mutex
+	return entry
+}
+
+// notify wakes any callers waiting for the lock to change state
+func (l *keyLock) notify() {
+	close(l.released)
+	l.released = make(chan struct{})
+}
+
+// holders returns a description of the operations currently holding the lock
+func (l *keyLock) holders() string {
+	holders := make([]string, 0)
+	if l.writer != nil {
+		holders = append(holders, l.writer.String())
+	}
+	for _, reader := range l.readers {
+		holders = append(holders, fmt.Sprintf("%s [read]", reader.String()))
+	}
+
+	if len(holders) == 0 {
+		return "no operation"
+	}
+
+	sort.Strings(holders)
+	return strings.Join(holders, ", ")
+}
+
+// newLockHolder returns a lockHolder for the calling operation - being the first function outside of this package
+func newLockHolder() *lockHolder {
+	holder := &lockHolder{
+		operation: "unknown operation",
+		since:     time.Now(),
+	}
+
+	pcs := make([]uintptr, 10)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+	for {
+		frame, more := frames.Next()
+		if frame.Function != "" && !isLocksPackageFrame(frame) {
+			holder.operation = frame.Function[strings.LastIndex(frame.Function, "/")+1:]
+			break
+		}
+		if !more {
+			break
+		}
+	}
+
+	return holder
+}
+
+// isLocksPackageFrame returns whether the frame is within the (non-test) source of this package
+func isLocksPackageFrame(frame runtime.Frame) bool {
+	return locksPackageDir != "" && filepath.Dir(frame.File) == locksPackageDir && !strings.HasSuffix(frame.File, "_test.go")
 }
 
 // newMutexKV returns a properly initialized mutexKV
 func newMutexKV() *mutexKV {
 	return &mutexKV{
-		store: make(map[string]*sync.Mutex),
+		store: make(map[string]*keyLock),
 	}
 }
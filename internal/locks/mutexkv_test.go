@@ -0,0 +1,160 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package locks
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMutexKVLockWithContextTimesOut(t *testing.T) {
+	m := newMutexKV()
+	m.Lock("key")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := m.LockWithContext(ctx, "key")
+	if err == nil {
+		t.Fatalf("expected an error when the lock is held")
+	}
+	if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		t.Fatalf("expected the context to have timed out")
+	}
+	if !strings.Contains(err.Error(), "locks.TestMutexKVLockWithContextTimesOut") {
+		t.Fatalf("expected the error to contain the holder of the lock, got %q", err.Error())
+	}
+
+	m.Unlock("key")
+	if err := m.LockWithContext(context.Background(), "key"); err != nil {
+		t.Fatalf("expected the lock to be acquired once released, got %+v", err)
+	}
+	m.Unlock("key")
+}
+
+func TestMutexKVLockWaitsForUnlock(t *testing.T) {
+	m := newMutexKV()
+	m.Lock("key")
+
+	locked := make(chan error)
+	go func() {
+		locked <- m.LockWithContext(context.Background(), "key")
+	}()
+
+	select {
+	case <-locked:
+		t.Fatalf("expected the lock to still be held")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	m.Unlock("key")
+
+	select {
+	case err := <-locked:
+		if err != nil {
+			t.Fatalf("expected the lock to be acquired, got %+v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for the lock to be acquired")
+	}
+	m.Unlock("key")
+}
+
+func TestMutexKVReadLocksAreShared(t *testing.T) {
+	m := newMutexKV()
+	m.RLock("key")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := m.RLockWithContext(ctx, "key"); err != nil {
+		t.Fatalf("expected a second read lock to be acquired, got %+v", err)
+	}
+
+	writeCtx, writeCancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer writeCancel()
+
+	err := m.LockWithContext(writeCtx, "key")
+	if err == nil {
+		t.Fatalf("expected the write lock to wait for the read locks")
+	}
+	if !strings.Contains(err.Error(), "[read]") {
+		t.Fatalf("expected the error to contain the read lock holders, got %q", err.Error())
+	}
+
+	m.RUnlock("key")
+	m.RUnlock("key")
+
+	if err := m.LockWithContext(ctx, "key"); err != nil {
+		t.Fatalf("expected the write lock to be acquired once the read locks are released, got %+v", err)
+	}
+	m.Unlock("key")
+}
+
+func TestMutexKVReadLockWaitsForWaitingWriter(t *testing.T) {
+	m := newMutexKV()
+	m.RLock("key")
+
+	writeLocked := make(chan error)
+	go func() {
+		writeLocked <- m.LockWithContext(context.Background(), "key")
+	}()
+
+	// wait for the writer to be waiting for the lock
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		m.lock.Lock()
+		waiting := m.get("key").waitingWriters
+		m.lock.Unlock()
+		if waiting > 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for the writer")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := m.RLockWithContext(ctx, "key"); err == nil {
+		t.Fatalf("expected the read lock to wait for the waiting writer")
+	}
+
+	m.RUnlock("key")
+
+	select {
+	case err := <-writeLocked:
+		if err != nil {
+			t.Fatalf("expected the write lock to be acquired, got %+v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for the write lock to be acquired")
+	}
+	m.Unlock("key")
+}
+
+func TestMultipleByIDWithContextReleasesLocksOnError(t *testing.T) {
+	ids := []string{"TestMultipleByIDWithContext/b", "TestMultipleByIDWithContext/a", "TestMultipleByIDWithContext/c"}
+
+	ByID("TestMultipleByIDWithContext/b")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if err := MultipleByIDWithContext(ctx, &ids); err == nil {
+		t.Fatalf("expected an error when one of the locks is held")
+	}
+
+	UnlockByID("TestMultipleByIDWithContext/b")
+
+	// `a` is locked before `b` so must have been released for this to succeed
+	if err := MultipleByIDWithContext(context.Background(), &ids); err != nil {
+		t.Fatalf("expected the locks to be acquired, got %+v", err)
+	}
+	UnlockMultipleByID(&ids)
+}
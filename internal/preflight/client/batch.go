@@ -0,0 +1,285 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	preflightClient "github.com/hashicorp/terraform-provider-azurerm/internal/preflight/sdk"
+)
+
+const (
+	// batchWindow is how long a batch waits for further resources with the same scope before it's sent. Terraform
+	// plans independent resources concurrently, so this gives their CustomizeDiff/ModifyPlan functions the chance to
+	// join the same batch.
+	batchWindow = 250 * time.Millisecond
+
+	// maxBatchSize is the maximum number of resources sent in a single validation request
+	maxBatchSize = 50
+
+	// defaultBatchTimeout is used when none of the resources in a batch were validated with a deadline
+	defaultBatchTimeout = 5 * time.Minute
+)
+
+// BatchRequest is a single resource to be validated as part of a batched validation request
+type BatchRequest struct {
+	Location   *string
+	Provider   string
+	Scope      string
+	Type       string
+	ResourceId string
+	Resource   preflightClient.ResourceValidationRequestResource
+}
+
+// NewBatchRequest constructs a BatchRequest for the resource. The resource type is derived from the ARM ID's path
+// segments unless resourceTypeOverride is specified.
+//
+// NOTE: the properties must represent the complete ARM PUT body for the resource, see internal/preflight/README.md
+func NewBatchRequest(location *string, id resourceids.ResourceId, resourceTypeOverride, apiVersion string, properties any) (*BatchRequest, error) {
+	scope, provider, resourceType, resourceName, err := ParseResourceId(id)
+	if err != nil {
+		return nil, fmt.Errorf("parsing resource ID for preflight validation: %w", err)
+	}
+
+	if resourceTypeOverride != "" {
+		resourceType = resourceTypeOverride
+	}
+
+	return &BatchRequest{
+		Location:   location,
+		Provider:   provider,
+		Scope:      scope,
+		Type:       resourceType,
+		ResourceId: id.ID(),
+		Resource: preflightClient.ResourceValidationRequestResource{
+			ApiVersion: apiVersion,
+			Name:       resourceName,
+			Type:       fmt.Sprintf("%s/%s", provider, resourceType),
+			Properties: properties,
+		},
+	}, nil
+}
+
+// batchKey contains the fields which are specified once per validation request, and so must be shared by each of the
+// resources within a batch
+type batchKey struct {
+	location     string
+	provider     string
+	scope        string
+	resourceType string
+}
+
+type batch struct {
+	key      batchKey
+	input    preflightClient.ResourceValidationRequest
+	entries  []*batchEntry
+	deadline time.Time
+	timer    *time.Timer
+}
+
+type batchEntry struct {
+	index      int
+	resourceId string
+	name       string
+	result     chan error
+}
+
+type batcher struct {
+	client *preflightClient.PreflightClient
+
+	lock    sync.Mutex
+	pending map[batchKey]*batch
+}
+
+func newBatcher(client *preflightClient.PreflightClient) *batcher {
+	return &batcher{
+		client:  client,
+		pending: make(map[batchKey]*batch),
+	}
+}
+
+// ValidateInBatch validates the resource using the Preflight Validation API. Resources sharing the same scope which
+// are validated at the same time are sent in a single request, with the errors returned for this resource only.
+func (c *Client) ValidateInBatch(ctx context.Context, input BatchRequest) error {
+	return c.batcher.validate(ctx, input)
+}
+
+func (b *batcher) validate(ctx context.Context, input BatchRequest) error {
+	key := batchKey{
+		location:     strings.ToLower(pointer.From(input.Location)),
+		provider:     strings.ToLower(input.Provider),
+		scope:        strings.ToLower(input.Scope),
+		resourceType: strings.ToLower(input.Type),
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(defaultBatchTimeout)
+	}
+
+	entry := &batchEntry{
+		resourceId: input.ResourceId,
+		name:       input.Resource.Name,
+		result:     make(chan error, 1),
+	}
+
+	b.lock.Lock()
+	pending, ok := b.pending[key]
+	if !ok {
+		pending = &batch{
+			key: key,
+			input: preflightClient.ResourceValidationRequest{
+				Location:       input.Location,
+				Provider:       input.Provider,
+				Resources:      make([]preflightClient.ResourceValidationRequestResource, 0),
+				Scope:          input.Scope,
+				Type:           input.Type,
+				ValidationType: pointer.To(preflightClient.ResourceValidationTypeArmFull),
+			},
+			deadline: deadline,
+		}
+		pending.timer = time.AfterFunc(batchWindow, func() {
+			b.flush(pending)
+		})
+		b.pending[key] = pending
+	}
+
+	entry.index = len(pending.entries)
+	pending.entries = append(pending.entries, entry)
+	pending.input.Resources = append(pending.input.Resources, input.Resource)
+	if deadline.After(pending.deadline) {
+		pending.deadline = deadline
+	}
+
+	if len(pending.entries) >= maxBatchSize {
+		pending.timer.Stop()
+		delete(b.pending, key)
+		go b.send(pending)
+	}
+	b.lock.Unlock()
+
+	select {
+	case err := <-entry.result:
+		return err
+	case <-ctx.Done():
+		return fmt.Errorf("waiting for preflight validation: %+v", ctx.Err())
+	}
+}
+
+// flush sends the batch once the batch window has elapsed, unless it has already been sent having reached the maximum size
+func (b *batcher) flush(pending *batch) {
+	b.lock.Lock()
+	if b.pending[pending.key] != pending {
+		b.lock.Unlock()
+		return
+	}
+	delete(b.pending, pending.key)
+	b.lock.Unlock()
+
+	b.send(pending)
+}
+
+func (b *batcher) send(pending *batch) {
+	// the batch is shared by several resources, so it's sent using the latest of their deadlines rather than the
+	// context of any one of them
+	ctx, cancel := context.WithDeadline(context.Background(), pending.deadline)
+	defer cancel()
+
+	resp, err := b.client.ValidateResources(ctx, pending.input)
+	if err != nil {
+		errorResp := ParseErrorResponse(resp.HttpResponse)
+		if errorResp == nil {
+			for _, entry := range pending.entries {
+				entry.result <- err
+			}
+			return
+		}
+
+		for _, entry := range pending.entries {
+			entry.result <- errorForEntry(*errorResp, pending.entries, entry, err)
+		}
+		return
+	}
+
+	var result error
+	switch {
+	case resp.Model == nil:
+		result = errors.New("missing model in validate response")
+	case len(resp.Model.Properties.ValidatedResources) < 1:
+		result = errors.New("validation did not return an error but there were no validated resources")
+	}
+
+	for _, entry := range pending.entries {
+		entry.result <- result
+	}
+}
+
+// errorForEntry returns the error for a single resource within a batch. Where the error details target specific
+// resources only the details for this resource are returned, and resources which weren't targeted are considered
+// valid. When none of the details target a resource in the batch, the whole error is returned for every resource.
+func errorForEntry(errorResp ErrorResponse, entries []*batchEntry, entry *batchEntry, err error) error {
+	if len(entries) > 1 {
+		targeted := false
+		details := make([]ErrorDetail, 0)
+		for _, detail := range errorResp.Error.Details {
+			for _, e := range entries {
+				if !detailTargetsEntry(detail, e) {
+					continue
+				}
+
+				targeted = true
+				if e == entry {
+					details = append(details, detail)
+				}
+			}
+		}
+
+		if targeted {
+			if len(details) == 0 {
+				return nil
+			}
+
+			if msg := errorResp.messageForDetails(details); msg != nil {
+				return errors.New(*msg)
+			}
+		}
+	}
+
+	if msg := errorResp.Message(); msg != nil {
+		return errors.New(*msg)
+	}
+
+	return err
+}
+
+// detailTargetsEntry returns whether the error detail, or any of its nested details, targets the resource - either by
+// Resource ID, by name, or by its position within the `resources` of the request
+func detailTargetsEntry(detail ErrorDetail, entry *batchEntry) bool {
+	if target := strings.ToLower(pointer.From(detail.Target)); target != "" {
+		resourceId := strings.ToLower(entry.resourceId)
+		name := strings.ToLower(entry.name)
+		index := fmt.Sprintf("resources[%d]", entry.index)
+
+		for _, prefix := range []string{resourceId, name, index} {
+			if prefix == "" {
+				continue
+			}
+			if target == prefix || strings.HasPrefix(target, prefix+"/") || strings.HasPrefix(target, prefix+".") {
+				return true
+			}
+		}
+	}
+
+	for _, child := range detail.Details {
+		if detailTargetsEntry(child, entry) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,161 @@
+package client
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+func TestErrorForEntry(t *testing.T) {
+	first := &batchEntry{
+		index:      0,
+		resourceId: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.EventGrid/namespaces/first",
+		name:       "first",
+	}
+	second := &batchEntry{
+		index:      1,
+		resourceId: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.EventGrid/namespaces/second",
+		name:       "second",
+	}
+	entries := []*batchEntry{first, second}
+	requestErr := errors.New("unexpected status 400")
+
+	cases := []struct {
+		Name     string
+		Error    ErrorResponse
+		Entries  []*batchEntry
+		Expected map[*batchEntry]string
+	}{
+		{
+			Name: "untargeted details are returned for every resource",
+			Error: ErrorResponse{
+				Error: ErrorBody{
+					Code:    "InvalidTemplate",
+					Message: "validation failed",
+					Details: []ErrorDetail{
+						{
+							Message: "something went wrong",
+						},
+					},
+				},
+			},
+			Entries: entries,
+			Expected: map[*batchEntry]string{
+				first:  "Error (InvalidTemplate): validation failed\nsomething went wrong",
+				second: "Error (InvalidTemplate): validation failed\nsomething went wrong",
+			},
+		},
+		{
+			Name: "details targeting a resource by ID are returned for that resource only",
+			Error: ErrorResponse{
+				Error: ErrorBody{
+					Code:    "InvalidTemplate",
+					Message: "validation failed",
+					Details: []ErrorDetail{
+						{
+							Target:  pointer.To(second.resourceId),
+							Message: "the sku is not available",
+						},
+					},
+				},
+			},
+			Entries: entries,
+			Expected: map[*batchEntry]string{
+				first:  "",
+				second: "Error (InvalidTemplate): validation failed\n" + second.resourceId + ": the sku is not available",
+			},
+		},
+		{
+			Name: "details targeting a resource by name or index",
+			Error: ErrorResponse{
+				Error: ErrorBody{
+					Message: "validation failed",
+					Details: []ErrorDetail{
+						{
+							Target:  pointer.To("First"),
+							Message: "denied by policy",
+						},
+						{
+							Target:  pointer.To("resources[1].properties.sku"),
+							Message: "invalid sku",
+						},
+					},
+				},
+			},
+			Entries: entries,
+			Expected: map[*batchEntry]string{
+				first:  "Error: validation failed\nFirst: denied by policy",
+				second: "Error: validation failed\nresources[1].properties.sku: invalid sku",
+			},
+		},
+		{
+			Name: "nested details targeting a resource",
+			Error: ErrorResponse{
+				Error: ErrorBody{
+					Message: "validation failed",
+					Details: []ErrorDetail{
+						{
+							Message: "policy violation",
+							Details: []ErrorDetail{
+								{
+									Target:  pointer.To("first"),
+									Message: "location not allowed",
+								},
+							},
+						},
+					},
+				},
+			},
+			Entries: entries,
+			Expected: map[*batchEntry]string{
+				first:  "Error: validation failed\npolicy violation\n  first: location not allowed",
+				second: "",
+			},
+		},
+		{
+			Name: "single resource returns the whole error",
+			Error: ErrorResponse{
+				Error: ErrorBody{
+					Message: "validation failed",
+					Details: []ErrorDetail{
+						{
+							Target:  pointer.To("another"),
+							Message: "denied by policy",
+						},
+					},
+				},
+			},
+			Entries: []*batchEntry{first},
+			Expected: map[*batchEntry]string{
+				first: "Error: validation failed\nanother: denied by policy",
+			},
+		},
+		{
+			Name:    "empty error falls back to the request error",
+			Error:   ErrorResponse{},
+			Entries: entries,
+			Expected: map[*batchEntry]string{
+				first:  requestErr.Error(),
+				second: requestErr.Error(),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			for entry, expected := range tc.Expected {
+				err := errorForEntry(tc.Error, tc.Entries, entry, requestErr)
+
+				actual := ""
+				if err != nil {
+					actual = err.Error()
+				}
+
+				if actual != expected {
+					t.Fatalf("expected the error for %q to be %q but got %q", entry.name, expected, actual)
+				}
+			}
+		})
+	}
+}
@@ -9,6 +9,8 @@ import (
 
 type Client struct {
 	PreflightClient *preflightClient.PreflightClient
+
+	batcher *batcher
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -20,5 +22,6 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 
 	return &Client{
 		PreflightClient: preflightCLient,
+		batcher:         newBatcher(preflightCLient),
 	}, nil
 }
@@ -1,4 +1,4 @@
-package preflight_test
+package client_test
 
 import (
 	"context"
@@ -11,13 +11,12 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/preflight"
+	preflight "github.com/hashicorp/terraform-provider-azurerm/internal/preflight/client"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/preflight/testdata"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 )
 
-func TestValidateResource(t *testing.T) {
+func TestValidateInBatch(t *testing.T) {
 	if os.Getenv("TF_ACC") == "" {
 		t.Skipf("Acceptance tests skipped unless env 'TF_ACC' set")
 	}
@@ -56,18 +55,14 @@ func TestValidateResource(t *testing.T) {
 		},
 	}
 
-	metadata := sdk.ResourceMetaData{
-		Client: client,
-	}
-
 	for _, tc := range cases {
 		t.Run(tc.Name, func(t *testing.T) {
-			request, err := preflight.NewValidationRequest(pointer.To(tc.Location), tc.ID, tc.APIVersion, tc.Properties)
+			request, err := preflight.NewBatchRequest(pointer.To(tc.Location), tc.ID, "", tc.APIVersion, tc.Properties)
 			if err != nil {
 				t.Fatalf("building validation request: %s", err)
 			}
 
-			err = request.ValidateResource(ctx, metadata)
+			err = client.Preflight.ValidateInBatch(ctx, *request)
 			if err != nil && !tc.ExpectError {
 				t.Fatalf("expected no error, got: %s", err)
 			}
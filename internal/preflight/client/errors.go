@@ -0,0 +1,93 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+type ErrorResponse struct {
+	Error ErrorBody `json:"error"`
+}
+
+type ErrorBody struct {
+	Code    string        `json:"code"`
+	Message string        `json:"message"`
+	Details []ErrorDetail `json:"details,omitempty"`
+}
+
+type ErrorDetail struct {
+	Code    string        `json:"code"`
+	Target  *string       `json:"target,omitempty"`
+	Message string        `json:"message,omitempty"`
+	Details []ErrorDetail `json:"details,omitempty"`
+}
+
+// ParseErrorResponse reads the error returned from the Preflight Validation API, returning nil when the
+// response has no body or the body isn't an ARM error
+func ParseErrorResponse(resp *http.Response) *ErrorResponse {
+	if resp == nil || resp.Body == nil {
+		return nil
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil
+	}
+
+	var errorResp ErrorResponse
+	if err := json.Unmarshal(bodyBytes, &errorResp); err != nil {
+		return nil
+	}
+
+	return &errorResp
+}
+
+// Message returns the top-level error message followed by each of the nested error details, or nil when the
+// error contains no messages
+func (e ErrorResponse) Message() *string {
+	return e.messageForDetails(e.Error.Details)
+}
+
+func (e ErrorResponse) messageForDetails(details []ErrorDetail) *string {
+	var lines []string
+
+	// nested error messages
+	var collect func(d ErrorDetail, indent int)
+	collect = func(d ErrorDetail, indent int) {
+		prefix := strings.Repeat("  ", indent)
+		if d.Message != "" {
+			if d.Target != nil && *d.Target != "" {
+				lines = append(lines, fmt.Sprintf("%s%s: %s", prefix, *d.Target, d.Message))
+			} else {
+				lines = append(lines, fmt.Sprintf("%s%s", prefix, d.Message))
+			}
+		}
+		for _, child := range d.Details {
+			collect(child, indent+1)
+		}
+	}
+
+	// top-level error message
+	if e.Error.Message != "" {
+		if e.Error.Code != "" {
+			lines = append(lines, fmt.Sprintf("Error (%s): %s", e.Error.Code, e.Error.Message))
+		} else {
+			lines = append(lines, fmt.Sprintf("Error: %s", e.Error.Message))
+		}
+	}
+
+	for _, d := range details {
+		collect(d, 0)
+	}
+
+	if len(lines) > 0 {
+		msg := strings.Join(lines, "\n")
+		return &msg
+	}
+
+	return nil
+}
@@ -0,0 +1,448 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	tagsSdk "github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/tags"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func expandDefaultTags(input map[string]interface{}) map[string]string {
+	output := make(map[string]string, len(input))
+
+	for k, v := range input {
+		// Validate should have ignored this error already
+		value, _ := tags.TagValueToString(v)
+		output[k] = value
+	}
+
+	return output
+}
+
+func expandIgnoreTags(input []interface{}) tags.IgnoreConfig {
+	output := tags.IgnoreConfig{}
+	if len(input) == 0 || input[0] == nil {
+		return output
+	}
+
+	v := input[0].(map[string]interface{})
+	for _, key := range v["keys"].(*schema.Set).List() {
+		output.Keys = append(output.Keys, key.(string))
+	}
+	for _, prefix := range v["key_prefixes"].(*schema.Set).List() {
+		output.KeyPrefixes = append(output.KeyPrefixes, prefix.(string))
+	}
+
+	return output
+}
+
+// supportsDefaultTags determines whether the Resource exposes the common top-level `tags` field, in which case the
+// default tags defined in the Provider block can be assigned to this Resource
+func supportsDefaultTags(resource *schema.Resource) bool {
+	v, ok := resource.Schema["tags"]
+	if !ok || v.Type != schema.TypeMap || !v.Optional {
+		return false
+	}
+
+	if elem, ok := v.Elem.(*schema.Schema); !ok || elem.Type != schema.TypeString {
+		return false
+	}
+
+	// this Resource has either already been decorated, or manages `tags_all` itself
+	_, exists := resource.Schema["tags_all"]
+	return !exists
+}
+
+// decorateResourceWithDefaultTags exposes a computed `tags_all` field on the Resource containing the default tags
+// merged with the tags defined on the Resource - and wraps the CRUD functions so that the merged tags are sent to
+// Azure, whilst the `tags` field only contains the tags defined on the Resource. Typed Resources are wrapped into
+// Plugin SDK Resources before being decorated, so the tags decoded into/encoded from their models are handled too.
+func decorateResourceWithDefaultTags(resource *schema.Resource) {
+	resource.Schema["tags_all"] = &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+
+	//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golangci-lint
+	supportsUpdate := resource.Update != nil || resource.UpdateContext != nil || resource.UpdateWithoutTimeout != nil //nolint:staticcheck
+
+	if resource.CustomizeDiff != nil {
+		resource.CustomizeDiff = pluginsdk.CustomDiffInSequence(resource.CustomizeDiff, defaultTagsCustomizeDiff(supportsUpdate))
+	} else {
+		resource.CustomizeDiff = defaultTagsCustomizeDiff(supportsUpdate)
+	}
+
+	//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golangci-lint
+	if f := resource.Create; f != nil { //nolint:staticcheck
+		resource.Create = func(d *schema.ResourceData, meta interface{}) error { //nolint:staticcheck
+			return withDefaultTags(d, meta, false, func() error {
+				return f(d, meta)
+			})
+		}
+	}
+	resource.CreateContext = wrapContextFuncWithDefaultTags(resource.CreateContext, false)
+	resource.CreateWithoutTimeout = wrapContextFuncWithDefaultTags(resource.CreateWithoutTimeout, false)
+
+	//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golangci-lint
+	if f := resource.Read; f != nil { //nolint:staticcheck
+		resource.Read = func(d *schema.ResourceData, meta interface{}) error { //nolint:staticcheck
+			return withDefaultTagsOnRead(d, meta, func() error {
+				return f(d, meta)
+			})
+		}
+	}
+	if f := resource.ReadContext; f != nil {
+		resource.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return readWithDefaultTagsDiagnostics(d, meta, func() diag.Diagnostics {
+				return f(ctx, d, meta)
+			})
+		}
+	}
+	if f := resource.ReadWithoutTimeout; f != nil {
+		resource.ReadWithoutTimeout = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return readWithDefaultTagsDiagnostics(d, meta, func() diag.Diagnostics {
+				return f(ctx, d, meta)
+			})
+		}
+	}
+
+	//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golangci-lint
+	if f := resource.Update; f != nil { //nolint:staticcheck
+		resource.Update = func(d *schema.ResourceData, meta interface{}) error { //nolint:staticcheck
+			return withDefaultTags(d, meta, true, func() error {
+				return f(d, meta)
+			})
+		}
+	}
+	resource.UpdateContext = wrapContextFuncWithDefaultTags(resource.UpdateContext, true)
+	resource.UpdateWithoutTimeout = wrapContextFuncWithDefaultTags(resource.UpdateWithoutTimeout, true)
+}
+
+// defaultTagsCustomizeDiff populates the planned value of `tags_all` so that the effective tags are visible in the plan
+func defaultTagsCustomizeDiff(supportsUpdate bool) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if !d.NewValueKnown("tags") {
+			return d.SetNewComputed("tags_all")
+		}
+
+		configured := d.Get("tags").(map[string]interface{})
+		merged := removeIgnoredTags(meta, tags.MergeDefault(defaultTagsFromMeta(meta), configured), configured)
+		if err := d.SetNew("tags_all", merged); err != nil {
+			return fmt.Errorf("setting `tags_all`: %+v", err)
+		}
+
+		// Resources which don't support being updated have to be recreated when the default tags change
+		if !supportsUpdate && d.Id() != "" && d.HasChange("tags_all") {
+			return d.ForceNew("tags_all")
+		}
+
+		return nil
+	}
+}
+
+func wrapContextFuncWithDefaultTags[T ~func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics](f T, isUpdate bool) T {
+	if f == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		var diags diag.Diagnostics
+		if err := withDefaultTags(d, meta, isUpdate, func() error {
+			diags = f(ctx, d, meta)
+			if diags.HasError() {
+				return errDiagnostics
+			}
+			return nil
+		}); err != nil && !errors.Is(err, errDiagnostics) {
+			return append(diags, diag.FromErr(err)...)
+		}
+
+		return diags
+	}
+}
+
+// errDiagnostics is used to signal that the wrapped function returned error diagnostics, which are returned as-is
+var errDiagnostics = errors.New("the wrapped function returned error diagnostics")
+
+// withDefaultTags assigns the merged tags to the `tags` field prior to calling the Create/Update function, so that
+// the default tags are sent to Azure, and then splits the tags assigned to the Resource into `tags` and `tags_all`.
+func withDefaultTags(d *schema.ResourceData, meta interface{}, isUpdate bool, f func() error) error {
+	defaultTags := defaultTagsFromMeta(meta)
+	configured := d.Get("tags").(map[string]interface{})
+	merged := tags.MergeDefault(defaultTags, configured)
+
+	// since the tags assigned to a Resource are replaced when it's updated, any existing tags which are ignored need
+	// to be sent to Azure to avoid removing the tags which are managed outside of Terraform
+	ignored := make(map[string]string)
+	if isUpdate {
+		ignored = retrieveIgnoredTagsAtScope(d, meta)
+	}
+	for k, v := range ignored {
+		if _, ok := merged[k]; !ok {
+			merged[k] = v
+		}
+	}
+
+	if len(defaultTags) > 0 || len(ignored) > 0 {
+		if err := d.Set("tags", merged); err != nil {
+			return fmt.Errorf("setting `tags`: %+v", err)
+		}
+	}
+
+	if err := f(); err != nil {
+		return err
+	}
+
+	if d.Id() == "" {
+		return nil
+	}
+
+	// when only the default tags have changed, Resources which only update tags when the `tags` field changes won't
+	// send the new default tags to Azure - as such these are updated using the Tags API
+	if isUpdate && d.HasChange("tags_all") && !d.HasChange("tags") {
+		updated, err := updateDefaultTagsAtScope(d, meta)
+		if err != nil {
+			return err
+		}
+		if updated {
+			if err := d.Set("tags", d.Get("tags_all")); err != nil {
+				return fmt.Errorf("setting `tags`: %+v", err)
+			}
+		}
+	}
+
+	return flattenDefaultTags(d, meta, configured)
+}
+
+func withDefaultTagsOnRead(d *schema.ResourceData, meta interface{}, f func() error) error {
+	// the tags in the state are those defined on the Resource, which are used to determine which default tags were
+	// explicitly configured on the Resource
+	configured := d.Get("tags").(map[string]interface{})
+
+	if err := f(); err != nil {
+		return err
+	}
+
+	if d.Id() == "" {
+		return nil
+	}
+
+	return flattenDefaultTags(d, meta, configured)
+}
+
+func readWithDefaultTagsDiagnostics(d *schema.ResourceData, meta interface{}, f func() diag.Diagnostics) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if err := withDefaultTagsOnRead(d, meta, func() error {
+		diags = f()
+		if diags.HasError() {
+			return errDiagnostics
+		}
+		return nil
+	}); err != nil && !errors.Is(err, errDiagnostics) {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+// flattenDefaultTags splits the tags assigned to the Resource into `tags_all` and `tags`, omitting any ignored tags
+// which aren't configured on the Resource
+func flattenDefaultTags(d *schema.ResourceData, meta interface{}, configured map[string]interface{}) error {
+	assigned := removeIgnoredTags(meta, d.Get("tags").(map[string]interface{}), configured)
+
+	if err := d.Set("tags_all", assigned); err != nil {
+		return fmt.Errorf("setting `tags_all`: %+v", err)
+	}
+
+	if err := d.Set("tags", tags.RemoveDefault(defaultTagsFromMeta(meta), assigned, configured)); err != nil {
+		return fmt.Errorf("setting `tags`: %+v", err)
+	}
+
+	return nil
+}
+
+// removeIgnoredTags removes the ignored tags from tagsMap - other than those which are configured on the Resource, which
+// are retained to avoid a perpetual diff on the `tags` field
+func removeIgnoredTags(meta interface{}, tagsMap map[string]interface{}, configured map[string]interface{}) map[string]interface{} {
+	output := ignoreTagsFromMeta(meta).Remove(tagsMap)
+
+	for k, v := range tagsMap {
+		if _, ok := configured[k]; ok {
+			output[k] = v
+		}
+	}
+
+	return output
+}
+
+// updateDefaultTagsAtScope updates the tags assigned to the Resource to match `tags_all` using the Tags API, returning
+// whether the tags were updated
+func updateDefaultTagsAtScope(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client, ok := meta.(*clients.Client)
+	if !ok || client == nil {
+		return false, nil
+	}
+
+	if !strings.HasPrefix(strings.ToLower(d.Id()), "/subscriptions/") {
+		log.Printf("[DEBUG] Skipping updating the default tags for %q since this isn't an Azure Resource Manager ID", d.Id())
+		return false, nil
+	}
+
+	ctx, cancel := timeouts.ForUpdate(client.StopContext, d)
+	defer cancel()
+
+	scope := commonids.NewScopeID(d.Id())
+	oldTags, newTags := d.GetChange("tags_all")
+
+	removed := make(map[string]string)
+	for k, v := range oldTags.(map[string]interface{}) {
+		if _, ok := newTags.(map[string]interface{})[k]; !ok {
+			removed[k] = v.(string)
+		}
+	}
+
+	if len(removed) > 0 {
+		payload := tagsSdk.TagsPatchResource{
+			Operation: pointer.To(tagsSdk.TagsPatchOperationDelete),
+			Properties: &tagsSdk.Tags{
+				Tags: pointer.To(removed),
+			},
+		}
+		if err := client.Resource.TagsClient.UpdateAtScopeThenPoll(ctx, scope, payload); err != nil {
+			return false, fmt.Errorf("removing default tags from %s: %+v", scope, err)
+		}
+	}
+
+	payload := tagsSdk.TagsPatchResource{
+		Operation: pointer.To(tagsSdk.TagsPatchOperationMerge),
+		Properties: &tagsSdk.Tags{
+			Tags: pointer.To(expandDefaultTags(newTags.(map[string]interface{}))),
+		},
+	}
+	if err := client.Resource.TagsClient.UpdateAtScopeThenPoll(ctx, scope, payload); err != nil {
+		return false, fmt.Errorf("assigning default tags to %s: %+v", scope, err)
+	}
+
+	return true, nil
+}
+
+// retrieveIgnoredTagsAtScope retrieves the tags currently assigned to the Resource which are ignored using the Tags API,
+// falling back to the ignored tags within the state when these can't be retrieved (e.g. due to missing permissions)
+func retrieveIgnoredTagsAtScope(d *schema.ResourceData, meta interface{}) map[string]string {
+	output := make(map[string]string)
+
+	client, ok := meta.(*clients.Client)
+	if !ok || client == nil || client.IgnoreTags.IsEmpty() {
+		return output
+	}
+
+	if !strings.HasPrefix(strings.ToLower(d.Id()), "/subscriptions/") {
+		log.Printf("[DEBUG] Skipping retrieving the ignored tags for %q since this isn't an Azure Resource Manager ID", d.Id())
+		return output
+	}
+
+	ctx, cancel := timeouts.ForUpdate(client.StopContext, d)
+	defer cancel()
+
+	scope := commonids.NewScopeID(d.Id())
+	resp, err := client.Resource.TagsClient.GetAtScope(ctx, scope)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to retrieve the tags for %s, falling back to the ignored tags within the state: %+v", scope, err)
+		existing, _ := d.GetChange("tags_all")
+		return client.IgnoreTags.Ignored(expandDefaultTags(existing.(map[string]interface{})))
+	}
+
+	if model := resp.Model; model != nil && model.Properties.Tags != nil {
+		output = client.IgnoreTags.Ignored(*model.Properties.Tags)
+	}
+
+	return output
+}
+
+// supportsIgnoreTags determines whether the Data Source exposes the common top-level `tags` field
+func supportsIgnoreTags(dataSource *schema.Resource) bool {
+	v, ok := dataSource.Schema["tags"]
+	if !ok || v.Type != schema.TypeMap || !v.Computed {
+		return false
+	}
+
+	elem, ok := v.Elem.(*schema.Schema)
+	return ok && elem.Type == schema.TypeString
+}
+
+// decorateDataSourceWithIgnoreTags wraps the Read function of the Data Source so that any ignored tags are omitted
+func decorateDataSourceWithIgnoreTags(dataSource *schema.Resource) {
+	//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golangci-lint
+	if f := dataSource.Read; f != nil { //nolint:staticcheck
+		dataSource.Read = func(d *schema.ResourceData, meta interface{}) error { //nolint:staticcheck
+			if err := f(d, meta); err != nil {
+				return err
+			}
+			return flattenIgnoreTags(d, meta)
+		}
+	}
+	if f := dataSource.ReadContext; f != nil {
+		dataSource.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			diags := f(ctx, d, meta)
+			if diags.HasError() {
+				return diags
+			}
+			return append(diags, diag.FromErr(flattenIgnoreTags(d, meta))...)
+		}
+	}
+	if f := dataSource.ReadWithoutTimeout; f != nil {
+		dataSource.ReadWithoutTimeout = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			diags := f(ctx, d, meta)
+			if diags.HasError() {
+				return diags
+			}
+			return append(diags, diag.FromErr(flattenIgnoreTags(d, meta))...)
+		}
+	}
+}
+
+func flattenIgnoreTags(d *schema.ResourceData, meta interface{}) error {
+	ignoreTags := ignoreTagsFromMeta(meta)
+	if ignoreTags.IsEmpty() {
+		return nil
+	}
+
+	if err := d.Set("tags", ignoreTags.Remove(d.Get("tags").(map[string]interface{}))); err != nil {
+		return fmt.Errorf("setting `tags`: %+v", err)
+	}
+
+	return nil
+}
+
+func ignoreTagsFromMeta(meta interface{}) tags.IgnoreConfig {
+	if client, ok := meta.(*clients.Client); ok && client != nil {
+		return client.IgnoreTags
+	}
+
+	return tags.IgnoreConfig{}
+}
+
+func defaultTagsFromMeta(meta interface{}) map[string]string {
+	if client, ok := meta.(*clients.Client); ok && client != nil {
+		return client.DefaultTags
+	}
+
+	return nil
+}
@@ -0,0 +1,419 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// resourcesExcludedFromDefaultTags are the Resources exposing a top-level `tags` field which doesn't contain the tags
+// assigned to the Resource in Azure, and as such don't support default tags - these are listed in the documentation
+var resourcesExcludedFromDefaultTags = map[string]struct{}{
+	"azurerm_api_management_named_value":             {},
+	"azurerm_api_management_workspace_named_value":   {},
+	"azurerm_sentinel_threat_intelligence_indicator": {},
+}
+
+func TestResourcesWithTagsExposeTagsAll(t *testing.T) {
+	provider := TestAzureProvider()
+
+	for resourceName, resource := range provider.ResourcesMap {
+		if _, ok := resource.Schema["tags"]; !ok {
+			continue
+		}
+
+		if _, excluded := resourcesExcludedFromDefaultTags[resourceName]; excluded {
+			if _, ok := resource.Schema["tags_all"]; ok {
+				t.Fatalf("the Resource %q is excluded from default tags but exposes a `tags_all` field", resourceName)
+			}
+			continue
+		}
+
+		tagsAll, ok := resource.Schema["tags_all"]
+		if !ok {
+			t.Fatalf("the Resource %q supports tags but doesn't expose a `tags_all` field", resourceName)
+		}
+
+		if !tagsAll.Computed || tagsAll.Optional || tagsAll.Required {
+			t.Fatalf("the Resource %q exposes a `tags_all` field which isn't Computed-only", resourceName)
+		}
+	}
+}
+
+func TestDecorateResourceWithDefaultTags(t *testing.T) {
+	var sent map[string]interface{}
+
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"tags": commonschema.Tags(),
+		},
+		//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golangci-lint
+		Create: func(d *schema.ResourceData, meta interface{}) error { //nolint:staticcheck
+			sent = d.Get("tags").(map[string]interface{})
+			d.SetId("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example")
+			return nil
+		},
+		//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golangci-lint
+		Read: func(d *schema.ResourceData, meta interface{}) error { //nolint:staticcheck
+			return d.Set("tags", sent)
+		},
+		//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golangci-lint
+		Delete: func(d *schema.ResourceData, meta interface{}) error { //nolint:staticcheck
+			return nil
+		},
+	}
+
+	if !supportsDefaultTags(resource) {
+		t.Fatalf("expected the Resource to support default tags")
+	}
+
+	decorateResourceWithDefaultTags(resource)
+
+	if supportsDefaultTags(resource) {
+		t.Fatalf("expected the Resource not to support being decorated twice")
+	}
+
+	meta := &clients.Client{
+		DefaultTags: map[string]string{
+			"cost-center": "1234",
+			"owner":       "platform",
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"name": "example",
+		"tags": map[string]interface{}{
+			"owner": "networking",
+		},
+	})
+
+	//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golangci-lint
+	if err := resource.Create(d, meta); err != nil { //nolint:staticcheck
+		t.Fatalf("creating: %+v", err)
+	}
+
+	expectedAll := map[string]interface{}{
+		"cost-center": "1234",
+		"owner":       "networking",
+	}
+	if !reflect.DeepEqual(sent, expectedAll) {
+		t.Fatalf("expected the tags sent to Azure to be %+v but got %+v", expectedAll, sent)
+	}
+
+	expectedTags := map[string]interface{}{
+		"owner": "networking",
+	}
+	if actual := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(actual, expectedTags) {
+		t.Fatalf("expected `tags` to be %+v but got %+v", expectedTags, actual)
+	}
+
+	if actual := d.Get("tags_all").(map[string]interface{}); !reflect.DeepEqual(actual, expectedAll) {
+		t.Fatalf("expected `tags_all` to be %+v but got %+v", expectedAll, actual)
+	}
+
+	//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golangci-lint
+	if err := resource.Read(d, meta); err != nil { //nolint:staticcheck
+		t.Fatalf("reading: %+v", err)
+	}
+
+	if actual := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(actual, expectedTags) {
+		t.Fatalf("expected `tags` to be %+v after reading but got %+v", expectedTags, actual)
+	}
+
+	// tags applied outside of Terraform which are ignored shouldn't be present in either `tags` or `tags_all`
+	sent["CreatedOnDate"] = "2025-01-01"
+	meta.IgnoreTags = tags.IgnoreConfig{
+		Keys: []string{"CreatedOnDate"},
+	}
+
+	//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golangci-lint
+	if err := resource.Read(d, meta); err != nil { //nolint:staticcheck
+		t.Fatalf("reading: %+v", err)
+	}
+
+	if actual := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(actual, expectedTags) {
+		t.Fatalf("expected `tags` to be %+v after reading but got %+v", expectedTags, actual)
+	}
+
+	if actual := d.Get("tags_all").(map[string]interface{}); !reflect.DeepEqual(actual, expectedAll) {
+		t.Fatalf("expected `tags_all` to be %+v after reading but got %+v", expectedAll, actual)
+	}
+}
+
+func TestDecorateResourceWithDefaultTagsRetainsConfiguredIgnoredTags(t *testing.T) {
+	var sent map[string]interface{}
+
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"tags": commonschema.Tags(),
+		},
+		//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golangci-lint
+		Create: func(d *schema.ResourceData, meta interface{}) error { //nolint:staticcheck
+			sent = d.Get("tags").(map[string]interface{})
+			d.SetId("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example")
+			return nil
+		},
+		//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golangci-lint
+		Read: func(d *schema.ResourceData, meta interface{}) error { //nolint:staticcheck
+			return d.Set("tags", sent)
+		},
+		//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golangci-lint
+		Delete: func(d *schema.ResourceData, meta interface{}) error { //nolint:staticcheck
+			return nil
+		},
+	}
+
+	decorateResourceWithDefaultTags(resource)
+
+	meta := &clients.Client{
+		IgnoreTags: tags.IgnoreConfig{
+			Keys:        []string{"CreatedOnDate"},
+			KeyPrefixes: []string{"hidden-link:"},
+		},
+	}
+
+	// an ignored tag which is configured on the Resource is managed by Terraform, and so should be retained
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"name": "example",
+		"tags": map[string]interface{}{
+			"CreatedOnDate": "2025-01-01",
+			"hello":         "there",
+		},
+	})
+
+	//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golangci-lint
+	if err := resource.Create(d, meta); err != nil { //nolint:staticcheck
+		t.Fatalf("creating: %+v", err)
+	}
+
+	// whereas an ignored tag assigned outside of Terraform should be omitted
+	sent["hidden-link:/subscriptions/123"] = "Resource"
+
+	//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golangci-lint
+	if err := resource.Read(d, meta); err != nil { //nolint:staticcheck
+		t.Fatalf("reading: %+v", err)
+	}
+
+	expected := map[string]interface{}{
+		"CreatedOnDate": "2025-01-01",
+		"hello":         "there",
+	}
+	if actual := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected `tags` to be %+v but got %+v", expected, actual)
+	}
+
+	if actual := d.Get("tags_all").(map[string]interface{}); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected `tags_all` to be %+v but got %+v", expected, actual)
+	}
+}
+
+type defaultTagsTypedResourceModel struct {
+	Name string            `tfschema:"name"`
+	Tags map[string]string `tfschema:"tags"`
+}
+
+// defaultTagsTypedResource is a minimal Typed Resource which assigns the tags using the model, to check that Typed
+// Resources are decorated in the same way as untyped Resources
+type defaultTagsTypedResource struct {
+	sent map[string]string
+}
+
+var _ sdk.Resource = &defaultTagsTypedResource{}
+
+func (r *defaultTagsTypedResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r *defaultTagsTypedResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r *defaultTagsTypedResource) ModelObject() interface{} {
+	return &defaultTagsTypedResourceModel{}
+}
+
+func (r *defaultTagsTypedResource) ResourceType() string {
+	return "azurerm_default_tags_typed_example"
+}
+
+func (r *defaultTagsTypedResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return nil
+}
+
+func (r *defaultTagsTypedResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model defaultTagsTypedResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			r.sent = model.Tags
+			metadata.SetID(commonids.NewResourceGroupID("00000000-0000-0000-0000-000000000000", model.Name))
+			return nil
+		},
+	}
+}
+
+func (r *defaultTagsTypedResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			return metadata.Encode(&defaultTagsTypedResourceModel{
+				Name: "example",
+				Tags: r.sent,
+			})
+		},
+	}
+}
+
+func (r *defaultTagsTypedResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			return nil
+		},
+	}
+}
+
+func TestDecorateTypedResourceWithDefaultTags(t *testing.T) {
+	typed := &defaultTagsTypedResource{}
+	wrapper := sdk.NewResourceWrapper(typed)
+	resource, err := wrapper.Resource()
+	if err != nil {
+		t.Fatalf("building Resource: %+v", err)
+	}
+
+	if !supportsDefaultTags(resource) {
+		t.Fatalf("expected the Typed Resource to support default tags")
+	}
+
+	decorateResourceWithDefaultTags(resource)
+
+	meta := &clients.Client{
+		DefaultTags: map[string]string{
+			"cost-center": "1234",
+			"owner":       "platform",
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"name": "example",
+		"tags": map[string]interface{}{
+			"owner": "networking",
+		},
+	})
+
+	if diags := resource.CreateContext(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("creating: %+v", diags)
+	}
+
+	expectedSent := map[string]string{
+		"cost-center": "1234",
+		"owner":       "networking",
+	}
+	if !reflect.DeepEqual(typed.sent, expectedSent) {
+		t.Fatalf("expected the tags sent to Azure to be %+v but got %+v", expectedSent, typed.sent)
+	}
+
+	expectedTags := map[string]interface{}{
+		"owner": "networking",
+	}
+	if actual := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(actual, expectedTags) {
+		t.Fatalf("expected `tags` to be %+v but got %+v", expectedTags, actual)
+	}
+
+	expectedAll := map[string]interface{}{
+		"cost-center": "1234",
+		"owner":       "networking",
+	}
+	if actual := d.Get("tags_all").(map[string]interface{}); !reflect.DeepEqual(actual, expectedAll) {
+		t.Fatalf("expected `tags_all` to be %+v but got %+v", expectedAll, actual)
+	}
+}
+
+func TestDecorateDataSourceWithIgnoreTags(t *testing.T) {
+	dataSource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"tags": commonschema.TagsDataSource(),
+		},
+		//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golangci-lint
+		Read: func(d *schema.ResourceData, meta interface{}) error { //nolint:staticcheck
+			d.SetId("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example")
+			return d.Set("tags", map[string]interface{}{
+				"CreatedOnDate":                  "2025-01-01",
+				"hidden-link:/subscriptions/123": "Resource",
+				"hello":                          "there",
+			})
+		},
+	}
+
+	if !supportsIgnoreTags(dataSource) {
+		t.Fatalf("expected the Data Source to support ignoring tags")
+	}
+
+	decorateDataSourceWithIgnoreTags(dataSource)
+
+	meta := &clients.Client{
+		IgnoreTags: expandIgnoreTags([]interface{}{
+			map[string]interface{}{
+				"keys":         schema.NewSet(schema.HashString, []interface{}{"createdondate"}),
+				"key_prefixes": schema.NewSet(schema.HashString, []interface{}{"hidden-link:"}),
+			},
+		}),
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSource.Schema, map[string]interface{}{
+		"name": "example",
+	})
+
+	//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golangci-lint
+	if err := dataSource.Read(d, meta); err != nil { //nolint:staticcheck
+		t.Fatalf("reading: %+v", err)
+	}
+
+	expected := map[string]interface{}{
+		"hello": "there",
+	}
+	if actual := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected `tags` to be %+v but got %+v", expected, actual)
+	}
+}
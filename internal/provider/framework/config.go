@@ -6,6 +6,7 @@ package framework
 import (
 	"context"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
@@ -16,6 +17,7 @@ import (
 	providerfeatures "github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 )
 
 type ProviderConfig struct {
@@ -127,6 +129,55 @@ func (p *ProviderConfig) Load(ctx context.Context, data *ProviderModel, tfVersio
 	p.clientBuilder.DisableTerraformPartnerID = getEnvBoolOrDefault(data.DisableTerraformPartnerId, "ARM_DISABLE_TERRAFORM_PARTNER_ID", false)
 	p.clientBuilder.StorageUseAzureAD = getEnvBoolOrDefault(data.StorageUseAzureAD, "ARM_STORAGE_USE_AZUREAD", false)
 
+	maxRequestsPerSecond, err := getEnvIntOrDefault(data.MaxRequestsPerSecond, "ARM_MAX_REQUESTS_PER_SECOND", 0)
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("validating ARM_MAX_REQUESTS_PER_SECOND", err.Error()))
+		return
+	}
+	if maxRequestsPerSecond < 0 {
+		diags.Append(diag.NewErrorDiagnostic("validating ARM_MAX_REQUESTS_PER_SECOND", "`max_requests_per_second` must be at least 0"))
+		return
+	}
+	p.clientBuilder.MaxRequestsPerSecond = maxRequestsPerSecond
+
+	// NOTE: getEnvBoolOrDefault returns true when the environment variable is unset, so can't be used for this opt-in setting
+	if !data.ThrottlingRetry.IsNull() && !data.ThrottlingRetry.IsUnknown() {
+		p.clientBuilder.ThrottlingRetry = data.ThrottlingRetry.ValueBool()
+	} else {
+		p.clientBuilder.ThrottlingRetry = strings.EqualFold(os.Getenv("ARM_THROTTLING_RETRY"), "true")
+	}
+
+	if !data.DefaultTags.IsNull() && !data.DefaultTags.IsUnknown() {
+		defaultTags := make(map[string]string)
+		diags.Append(data.DefaultTags.ElementsAs(ctx, &defaultTags, false)...)
+		if diags.HasError() {
+			return
+		}
+		p.clientBuilder.DefaultTags = defaultTags
+	}
+
+	if !data.IgnoreTags.IsNull() && !data.IgnoreTags.IsUnknown() {
+		var ignoreTagsList []IgnoreTags
+		diags.Append(data.IgnoreTags.ElementsAs(ctx, &ignoreTagsList, true)...)
+		if diags.HasError() {
+			return
+		}
+
+		if len(ignoreTagsList) > 0 {
+			ignoreTags := tags.IgnoreConfig{}
+			if v := ignoreTagsList[0].Keys; !v.IsNull() && !v.IsUnknown() {
+				diags.Append(v.ElementsAs(ctx, &ignoreTags.Keys, false)...)
+			}
+			if v := ignoreTagsList[0].KeyPrefixes; !v.IsNull() && !v.IsUnknown() {
+				diags.Append(v.ElementsAs(ctx, &ignoreTags.KeyPrefixes, false)...)
+			}
+			if diags.HasError() {
+				return
+			}
+			p.clientBuilder.IgnoreTags = ignoreTags
+		}
+	}
+
 	if os.Getenv("ARM_PROVIDER_ENHANCED_VALIDATION") != "" {
 		diags.Append(diag.NewErrorDiagnostic("unsupported environment variable", "the environment variable `ARM_PROVIDER_ENHANCED_VALIDATION` has been removed in v5.0 of the AzureRM Provider - please use the `enhanced_validation` block inside the `features` block or the replacement environment variables `ARM_PROVIDER_ENHANCED_VALIDATION_LOCATIONS` and `ARM_PROVIDER_ENHANCED_VALIDATION_RESOURCE_PROVIDERS` instead"))
 		return
@@ -36,6 +36,10 @@ type ProviderModel struct {
 	DisableCorrelationRequestId    types.Bool   `tfsdk:"disable_correlation_request_id"`
 	DisableTerraformPartnerId      types.Bool   `tfsdk:"disable_terraform_partner_id"`
 	StorageUseAzureAD              types.Bool   `tfsdk:"storage_use_azuread"`
+	MaxRequestsPerSecond           types.Int64  `tfsdk:"max_requests_per_second"`
+	ThrottlingRetry                types.Bool   `tfsdk:"throttling_retry"`
+	DefaultTags                    types.Map    `tfsdk:"default_tags"`
+	IgnoreTags                     types.List   `tfsdk:"ignore_tags"`
 	Features                       types.List   `tfsdk:"features"`
 	ResourceProviderRegistrations  types.String `tfsdk:"resource_provider_registrations"`
 	ResourceProvidersToRegister    types.List   `tfsdk:"resource_providers_to_register"`
@@ -297,6 +301,16 @@ var ServiceBusAttributes = map[string]attr.Type{
 	"auto_delete_subscription_default_rule": types.BoolType,
 }
 
+type IgnoreTags struct {
+	Keys        types.Set `tfsdk:"keys"`
+	KeyPrefixes types.Set `tfsdk:"key_prefixes"`
+}
+
+var IgnoreTagsAttributes = map[string]attr.Type{
+	"keys":         types.SetType{ElemType: types.StringType},
+	"key_prefixes": types.SetType{ElemType: types.StringType},
+}
+
 type EnhancedValidationModel struct {
 	Locations         types.Bool   `tfsdk:"locations"`
 	ResourceProviders types.Bool   `tfsdk:"resource_providers"`
@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/action"
@@ -203,6 +204,25 @@ func (p *azureRmFrameworkProvider) Schema(_ context.Context, _ provider.SchemaRe
 				Description: "Should the AzureRM Provider use Azure AD Authentication when accessing the Storage Data Plane APIs?",
 			},
 
+			"max_requests_per_second": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The maximum number of requests per second which should be made to Azure, shared across all clients. Defaults to `0`, meaning requests aren't rate limited.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+
+			"throttling_retry": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Should requests to Azure Resource Manager be delayed when the remaining rate limit for the Subscription or Tenant is low, and be paused for all clients when a request is throttled?",
+			},
+
+			"default_tags": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "A mapping of tags which should be assigned to all Resources managed by this Provider which support tags. Tags defined on a Resource take precedence over a default tag with the same key.",
+			},
+
 			"resource_provider_registrations": schema.StringAttribute{
 				Optional:    true,
 				Description: "The set of Resource Providers which should be automatically registered for the subscription.",
@@ -232,6 +252,28 @@ func (p *azureRmFrameworkProvider) Schema(_ context.Context, _ provider.SchemaRe
 		},
 
 		Blocks: map[string]schema.Block{
+			"ignore_tags": schema.ListNestedBlock{
+				Description: "Tags which are managed outside of Terraform and should be ignored when reading the tags assigned to Resources and Data Sources.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"keys": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "A list of tag keys which should be ignored, compared case-insensitively.",
+						},
+
+						"key_prefixes": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "A list of tag key prefixes, where tags with a matching key should be ignored, compared case-insensitively.",
+						},
+					},
+				},
+			},
+
 			"features": schema.ListNestedBlock{
 				Validators: []validator.List{
 					listvalidator.SizeBetween(1, 1),
@@ -613,8 +655,16 @@ func (p *azureRmFrameworkProvider) EphemeralResources(_ context.Context) []func(
 
 func (p *azureRmFrameworkProvider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
+		providerfunction.NewBuildExtensionResourceIDFunction,
+		providerfunction.NewBuildResourceGroupResourceIDFunction,
+		providerfunction.NewBuildResourceIDFunction,
+		providerfunction.NewBuildSubscriptionResourceIDFunction,
 		providerfunction.NewNormaliseResourceIDFunction,
 		providerfunction.NewParseResourceIDFunction,
+		providerfunction.NewResourceNameFunction,
+		providerfunction.NewSubnetFitsServiceFunction,
+		providerfunction.NewSubnetUsableHostsFunction,
+		providerfunction.NewVnetNextFreeSubnetFunction,
 	}
 }
 
@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+type BuildExtensionResourceIDFunction struct{}
+
+var _ function.Function = BuildExtensionResourceIDFunction{}
+
+func NewBuildExtensionResourceIDFunction() function.Function {
+	return &BuildExtensionResourceIDFunction{}
+}
+
+func (b BuildExtensionResourceIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "build_extension_resource_id"
+}
+
+func (b BuildExtensionResourceIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "build_extension_resource_id",
+		Description:         "Builds an Azure Resource Manager ID for an Extension Resource which is applied to another Resource from its component parts",
+		MarkdownDescription: "Builds an Azure Resource Manager ID for an Extension Resource which is applied to another Resource from its component parts",
+		Parameters: append([]function.Parameter{
+			function.StringParameter{
+				Name:                "resource_id",
+				Description:         "The ID of the Resource (including a Subscription or Resource Group) which the Extension Resource is applied to",
+				MarkdownDescription: "The ID of the Resource (including a Subscription or Resource Group) which the Extension Resource is applied to",
+			},
+		}, buildResourceIDProviderParameters()...),
+		VariadicParameter: buildResourceIDNamesParameter(),
+		Return:            function.StringReturn{},
+	}
+}
+
+func (b BuildExtensionResourceIDFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var resourceId, resourceProvider, resourceType string
+	var resourceNames []string
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &resourceId, &resourceProvider, &resourceType, &resourceNames))
+
+	if response.Error != nil {
+		return
+	}
+
+	// Extension Resources are applied to another Resource (which can be a Subscription or Resource Group), as such
+	// `resource_id` must itself be a Resource ID rather than the Tenant root
+	if !strings.HasPrefix(resourceId, "/") || strings.TrimSuffix(resourceId, "/") == "" {
+		response.Error = function.NewArgumentFuncError(0, fmt.Sprintf("expected `resource_id` to be a Resource ID but got %q", resourceId))
+		return
+	}
+
+	prefix := []resourceids.Segment{
+		resourceids.ScopeSegment("scope", "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/some-resource-group/providers/Microsoft.Compute/virtualMachines/some-virtual-machine"),
+	}
+	values := map[string]string{
+		"scope": resourceId,
+	}
+
+	id, err := buildResourceID(prefix, values, resourceProvider, resourceType, resourceNames)
+	if err != nil {
+		response.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, id))
+}
@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+type BuildResourceGroupResourceIDFunction struct{}
+
+var _ function.Function = BuildResourceGroupResourceIDFunction{}
+
+func NewBuildResourceGroupResourceIDFunction() function.Function {
+	return &BuildResourceGroupResourceIDFunction{}
+}
+
+func (b BuildResourceGroupResourceIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "build_resource_group_resource_id"
+}
+
+func (b BuildResourceGroupResourceIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "build_resource_group_resource_id",
+		Description:         "Builds an Azure Resource Manager ID for a Resource within a Resource Group from its component parts",
+		MarkdownDescription: "Builds an Azure Resource Manager ID for a Resource within a Resource Group from its component parts",
+		Parameters: append([]function.Parameter{
+			function.StringParameter{
+				Name:                "subscription_id",
+				Description:         "The ID of the Subscription the Resource Group exists within",
+				MarkdownDescription: "The ID of the Subscription the Resource Group exists within",
+			},
+			function.StringParameter{
+				Name:                "resource_group_name",
+				Description:         "The name of the Resource Group the Resource exists within",
+				MarkdownDescription: "The name of the Resource Group the Resource exists within",
+			},
+		}, buildResourceIDProviderParameters()...),
+		VariadicParameter: buildResourceIDNamesParameter(),
+		Return:            function.StringReturn{},
+	}
+}
+
+func (b BuildResourceGroupResourceIDFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var subscriptionId, resourceGroupName, resourceProvider, resourceType string
+	var resourceNames []string
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &subscriptionId, &resourceGroupName, &resourceProvider, &resourceType, &resourceNames))
+
+	if response.Error != nil {
+		return
+	}
+
+	prefix := []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+	}
+	values := map[string]string{
+		"subscriptionId":    subscriptionId,
+		"resourceGroupName": resourceGroupName,
+	}
+
+	id, err := buildResourceID(prefix, values, resourceProvider, resourceType, resourceNames)
+	if err != nil {
+		response.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, id))
+}
@@ -0,0 +1,221 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+type BuildResourceIDFunction struct{}
+
+var _ function.Function = BuildResourceIDFunction{}
+
+func NewBuildResourceIDFunction() function.Function {
+	return &BuildResourceIDFunction{}
+}
+
+func (b BuildResourceIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "build_resource_id"
+}
+
+func (b BuildResourceIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "build_resource_id",
+		Description:         "Builds an Azure Resource Manager ID for a Resource within the specified Scope from its component parts",
+		MarkdownDescription: "Builds an Azure Resource Manager ID for a Resource within the specified Scope from its component parts",
+		Parameters: append([]function.Parameter{
+			function.StringParameter{
+				Name:                "scope",
+				Description:         "The ID of the Scope the Resource exists within, such as a Subscription, Resource Group or Management Group, or `/` for a Tenant level Resource",
+				MarkdownDescription: "The ID of the Scope the Resource exists within, such as a Subscription, Resource Group or Management Group, or `/` for a Tenant level Resource",
+			},
+		}, buildResourceIDProviderParameters()...),
+		VariadicParameter: buildResourceIDNamesParameter(),
+		Return:            function.StringReturn{},
+	}
+}
+
+func (b BuildResourceIDFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var scope, resourceProvider, resourceType string
+	var resourceNames []string
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &scope, &resourceProvider, &resourceType, &resourceNames))
+
+	if response.Error != nil {
+		return
+	}
+
+	if !strings.HasPrefix(scope, "/") {
+		response.Error = function.NewArgumentFuncError(0, fmt.Sprintf("expected `scope` to be a Resource ID beginning with `/` but got %q", scope))
+		return
+	}
+
+	var prefix []resourceids.Segment
+	values := make(map[string]string)
+	if scope = strings.TrimSuffix(scope, "/"); scope != "" {
+		prefix = append(prefix, resourceids.ScopeSegment("scope", "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/some-resource-group"))
+		values["scope"] = scope
+	}
+
+	id, err := buildResourceID(prefix, values, resourceProvider, resourceType, resourceNames)
+	if err != nil {
+		response.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, id))
+}
+
+// buildResourceIDProviderParameters returns the parameters describing the Resource Provider and Resource Type which
+// are common to each of the `build_*_resource_id` functions
+func buildResourceIDProviderParameters() []function.Parameter {
+	return []function.Parameter{
+		function.StringParameter{
+			Name:                "resource_provider",
+			Description:         "The Resource Provider namespace, for example `Microsoft.Sql`",
+			MarkdownDescription: "The Resource Provider namespace, for example `Microsoft.Sql`",
+		},
+		function.StringParameter{
+			Name:                "resource_type",
+			Description:         "The Resource Type including any parent Resource Types separated by `/`, for example `servers/databases`",
+			MarkdownDescription: "The Resource Type including any parent Resource Types separated by `/`, for example `servers/databases`",
+		},
+	}
+}
+
+func buildResourceIDNamesParameter() function.Parameter {
+	return function.StringParameter{
+		Name:                "resource_names",
+		Description:         "The name of each of the Resources within the Resource Type, starting with the top-most parent Resource",
+		MarkdownDescription: "The name of each of the Resources within the Resource Type, starting with the top-most parent Resource",
+	}
+}
+
+// buildResourceID composes a Resource ID from the `prefix` Segments (whose values are specified in `values`) followed by
+// the Resource Provider, Resource Types and Resource Names - and then validates the result using the same parser used
+// for the Resource IDs within the Provider, to ensure the ID is well-formed.
+func buildResourceID(prefix []resourceids.Segment, values map[string]string, resourceProvider, resourceType string, resourceNames []string) (string, error) {
+	if resourceProvider == "" || strings.Contains(resourceProvider, "/") {
+		return "", fmt.Errorf("expected `resource_provider` to be a Resource Provider namespace (for example `Microsoft.Sql`) but got %q", resourceProvider)
+	}
+
+	resourceTypes := strings.Split(strings.Trim(resourceType, "/"), "/")
+	for _, v := range resourceTypes {
+		if v == "" {
+			return "", fmt.Errorf("expected `resource_type` to be a Resource Type (for example `servers/databases`) but got %q", resourceType)
+		}
+	}
+
+	if len(resourceNames) != len(resourceTypes) {
+		return "", fmt.Errorf("the Resource Type %q requires %d Resource Name(s) but got %d", resourceType, len(resourceTypes), len(resourceNames))
+	}
+
+	id := buildableResourceId{
+		segments: append([]resourceids.Segment{}, prefix...),
+		values:   make(map[string]string),
+	}
+	for k, v := range values {
+		id.values[k] = v
+	}
+
+	id.segments = append(id.segments,
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticResourceProvider", resourceProvider, resourceProvider),
+	)
+	for i, v := range resourceTypes {
+		name := resourceNames[i]
+		if name == "" || strings.Contains(name, "/") {
+			return "", fmt.Errorf("expected the name of the %q Resource to be a non-empty value without a `/` but got %q", v, name)
+		}
+
+		segmentName := fmt.Sprintf("resourceName%d", i)
+		id.segments = append(id.segments,
+			resourceids.StaticSegment(fmt.Sprintf("static%d", i), v, v),
+			resourceids.UserSpecifiedSegment(segmentName, name),
+		)
+		id.values[segmentName] = name
+	}
+
+	for _, v := range id.segments {
+		if v.FixedValue == nil && id.values[v.Name] == "" {
+			return "", fmt.Errorf("a value must be specified for the %q segment", v.Name)
+		}
+	}
+
+	raw := id.ID()
+	parser := resourceids.NewParserFromResourceIdType(&id)
+	parsed, err := parser.Parse(raw, false)
+	if err != nil {
+		return "", fmt.Errorf("validating the Resource ID %q: %+v", raw, err)
+	}
+
+	if err := id.FromParseResult(*parsed); err != nil {
+		return "", fmt.Errorf("validating the Resource ID %q: %+v", raw, err)
+	}
+
+	if result := id.ID(); result != raw {
+		return "", fmt.Errorf("validating the Resource ID %q: parsed value %q did not match", raw, result)
+	}
+
+	return raw, nil
+}
+
+var _ resourceids.ResourceId = &buildableResourceId{}
+
+// buildableResourceId is a Resource ID whose Segments are defined at runtime, allowing the IDs built by the
+// `build_*_resource_id` functions to be validated by the Resource ID parser
+type buildableResourceId struct {
+	segments []resourceids.Segment
+	values   map[string]string
+}
+
+func (id *buildableResourceId) FromParseResult(input resourceids.ParseResult) error {
+	values := make(map[string]string)
+	for _, segment := range id.segments {
+		if segment.FixedValue != nil {
+			continue
+		}
+
+		v, ok := input.Parsed[segment.Name]
+		if !ok {
+			return resourceids.NewSegmentNotSpecifiedError(id, segment.Name, input)
+		}
+		values[segment.Name] = v
+	}
+
+	id.values = values
+	return nil
+}
+
+func (id *buildableResourceId) ID() string {
+	var sb strings.Builder
+	for _, segment := range id.segments {
+		switch segment.Type {
+		case resourceids.ScopeSegmentType:
+			sb.WriteString("/" + strings.Trim(id.values[segment.Name], "/"))
+
+		case resourceids.ResourceProviderSegmentType, resourceids.StaticSegmentType:
+			sb.WriteString("/" + pointer.From(segment.FixedValue))
+
+		default:
+			sb.WriteString("/" + id.values[segment.Name])
+		}
+	}
+
+	return sb.String()
+}
+
+func (id *buildableResourceId) String() string {
+	return fmt.Sprintf("Resource ID %q", id.ID())
+}
+
+func (id *buildableResourceId) Segments() []resourceids.Segment {
+	return id.segments
+}
@@ -0,0 +1,137 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestProviderFunctionBuildResourceID_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+			"azurerm": func() (tfprotov5.ProviderServer, error) { // nolint:unparam
+				return framework.V5ProviderWithoutPluginSDK()(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testBuildResourceIdOutput(),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("resource_group", "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Sql/servers/server1/databases/database1"),
+					acceptance.TestCheckOutput("subscription", "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Security/pricings/VirtualMachines"),
+					acceptance.TestCheckOutput("extension", "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/virtualMachines/machine1/providers/Microsoft.Authorization/locks/lock1"),
+					acceptance.TestCheckOutput("scoped", "/providers/Microsoft.Management/managementGroups/group1/providers/Microsoft.Authorization/policyDefinitions/policy1"),
+					acceptance.TestCheckOutput("tenant", "/providers/Microsoft.Capacity/reservationOrders/order1"),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionBuildResourceID_incorrectNumberOfNames(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+			"azurerm": func() (tfprotov5.ProviderServer, error) { // nolint:unparam
+				return framework.V5ProviderWithoutPluginSDK()(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testBuildResourceIdIncorrectNumberOfNames(),
+				ExpectError: regexp.MustCompile("requires 2 Resource Name"),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionBuildResourceID_invalidExtensionScope(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+			"azurerm": func() (tfprotov5.ProviderServer, error) { // nolint:unparam
+				return framework.V5ProviderWithoutPluginSDK()(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testBuildResourceIdInvalidExtensionScope(),
+				ExpectError: regexp.MustCompile("expected `resource_id` to be a Resource ID"),
+			},
+		},
+	})
+}
+
+func testBuildResourceIdOutput() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+output "resource_group" {
+  value = provider::azurerm::build_resource_group_resource_id("12345678-1234-9876-4563-123456789012", "resGroup1", "Microsoft.Sql", "servers/databases", "server1", "database1")
+}
+
+output "subscription" {
+  value = provider::azurerm::build_subscription_resource_id("12345678-1234-9876-4563-123456789012", "Microsoft.Security", "pricings", "VirtualMachines")
+}
+
+output "extension" {
+  value = provider::azurerm::build_extension_resource_id("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/virtualMachines/machine1", "Microsoft.Authorization", "locks", "lock1")
+}
+
+output "scoped" {
+  value = provider::azurerm::build_resource_id("/providers/Microsoft.Management/managementGroups/group1", "Microsoft.Authorization", "policyDefinitions", "policy1")
+}
+
+output "tenant" {
+  value = provider::azurerm::build_resource_id("/", "Microsoft.Capacity", "reservationOrders", "order1")
+}
+`
+}
+
+func testBuildResourceIdIncorrectNumberOfNames() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+output "test" {
+  value = provider::azurerm::build_resource_group_resource_id("12345678-1234-9876-4563-123456789012", "resGroup1", "Microsoft.Sql", "servers/databases", "server1")
+}
+`
+}
+
+func testBuildResourceIdInvalidExtensionScope() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+output "test" {
+  value = provider::azurerm::build_extension_resource_id("/", "Microsoft.Authorization", "locks", "lock1")
+}
+`
+}
@@ -0,0 +1,67 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+type BuildSubscriptionResourceIDFunction struct{}
+
+var _ function.Function = BuildSubscriptionResourceIDFunction{}
+
+func NewBuildSubscriptionResourceIDFunction() function.Function {
+	return &BuildSubscriptionResourceIDFunction{}
+}
+
+func (b BuildSubscriptionResourceIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "build_subscription_resource_id"
+}
+
+func (b BuildSubscriptionResourceIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "build_subscription_resource_id",
+		Description:         "Builds an Azure Resource Manager ID for a Resource deployed at the Subscription level from its component parts",
+		MarkdownDescription: "Builds an Azure Resource Manager ID for a Resource deployed at the Subscription level from its component parts",
+		Parameters: append([]function.Parameter{
+			function.StringParameter{
+				Name:                "subscription_id",
+				Description:         "The ID of the Subscription the Resource exists within",
+				MarkdownDescription: "The ID of the Subscription the Resource exists within",
+			},
+		}, buildResourceIDProviderParameters()...),
+		VariadicParameter: buildResourceIDNamesParameter(),
+		Return:            function.StringReturn{},
+	}
+}
+
+func (b BuildSubscriptionResourceIDFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var subscriptionId, resourceProvider, resourceType string
+	var resourceNames []string
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &subscriptionId, &resourceProvider, &resourceType, &resourceNames))
+
+	if response.Error != nil {
+		return
+	}
+
+	prefix := []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+	}
+	values := map[string]string{
+		"subscriptionId": subscriptionId,
+	}
+
+	id, err := buildResourceID(prefix, values, resourceProvider, resourceType, resourceNames)
+	if err != nil {
+		response.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, id))
+}
@@ -0,0 +1,231 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	appServiceValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
+	containerValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	cosmosValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/validate"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	logAnalyticsValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/validate"
+	mssqlValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/validate"
+	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// resourceNameHashSuffixLength is the number of characters of the hash which are appended to the name when hash
+// inputs are specified
+const resourceNameHashSuffixLength = 6
+
+type resourceNameRule struct {
+	maxLength int
+
+	// lowercase specifies whether the name must be lowercase
+	lowercase bool
+
+	// disallowedCharacters matches the characters which cannot be used within the name
+	disallowedCharacters *regexp.Regexp
+
+	// separator is used to replace disallowed characters and to separate the hash suffix, or empty when the name
+	// cannot contain a separator
+	separator string
+
+	// mustStartWithLetter specifies whether the first character of the name must be a letter
+	mustStartWithLetter bool
+
+	// validateFunc is the validation function used for the `name` field of the Resource
+	validateFunc pluginsdk.SchemaValidateFunc
+}
+
+var resourceNameRules = map[string]resourceNameRule{
+	"azurerm_container_registry": {
+		maxLength:            50,
+		disallowedCharacters: regexp.MustCompile(`[^a-zA-Z0-9]`),
+		validateFunc:         containerValidate.ContainerRegistryName,
+	},
+	"azurerm_cosmosdb_account": {
+		maxLength:            50,
+		lowercase:            true,
+		disallowedCharacters: regexp.MustCompile(`[^a-z0-9-]`),
+		separator:            "-",
+		validateFunc:         cosmosValidate.CosmosAccountName,
+	},
+	"azurerm_key_vault": {
+		maxLength:            24,
+		disallowedCharacters: regexp.MustCompile(`[^a-zA-Z0-9-]`),
+		separator:            "-",
+		mustStartWithLetter:  true,
+		validateFunc:         keyVaultValidate.VaultName,
+	},
+	"azurerm_kubernetes_cluster": {
+		maxLength:            63,
+		disallowedCharacters: regexp.MustCompile(`[^a-zA-Z0-9_-]`),
+		separator:            "-",
+		validateFunc:         containerValidate.KubernetesClusterName,
+	},
+	"azurerm_linux_web_app": {
+		maxLength:            60,
+		disallowedCharacters: regexp.MustCompile(`[^a-zA-Z0-9-]`),
+		separator:            "-",
+		validateFunc:         appServiceValidate.WebAppName,
+	},
+	"azurerm_log_analytics_workspace": {
+		maxLength:            63,
+		disallowedCharacters: regexp.MustCompile(`[^a-zA-Z0-9-]`),
+		separator:            "-",
+		validateFunc:         logAnalyticsValidate.LogAnalyticsWorkspaceName,
+	},
+	"azurerm_mssql_server": {
+		maxLength:            63,
+		lowercase:            true,
+		disallowedCharacters: regexp.MustCompile(`[^a-z0-9-]`),
+		separator:            "-",
+		validateFunc:         mssqlValidate.ValidateMsSqlServerName,
+	},
+	"azurerm_storage_account": {
+		maxLength:            24,
+		lowercase:            true,
+		disallowedCharacters: regexp.MustCompile(`[^a-z0-9]`),
+		validateFunc:         storageValidate.StorageAccountName,
+	},
+	"azurerm_windows_web_app": {
+		maxLength:            60,
+		disallowedCharacters: regexp.MustCompile(`[^a-zA-Z0-9-]`),
+		separator:            "-",
+		validateFunc:         appServiceValidate.WebAppName,
+	},
+}
+
+type ResourceNameFunction struct{}
+
+var _ function.Function = ResourceNameFunction{}
+
+func NewResourceNameFunction() function.Function {
+	return &ResourceNameFunction{}
+}
+
+func (r ResourceNameFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "resource_name"
+}
+
+func (r ResourceNameFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "resource_name",
+		Description:         "Generates a name which meets the naming rules of the specified Resource Type from a base name",
+		MarkdownDescription: "Generates a name which meets the naming rules of the specified Resource Type from a base name",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "resource_type",
+				Description:         fmt.Sprintf("The Terraform Resource Type the name is for. Possible values are %s", strings.Join(resourceNameResourceTypes(), ", ")),
+				MarkdownDescription: fmt.Sprintf("The Terraform Resource Type the name is for. Possible values are `%s`", strings.Join(resourceNameResourceTypes(), "`, `")),
+			},
+			function.StringParameter{
+				Name:                "name",
+				Description:         "The desired base name, which is sanitised and truncated to meet the naming rules of the Resource Type",
+				MarkdownDescription: "The desired base name, which is sanitised and truncated to meet the naming rules of the Resource Type",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:                "hash_inputs",
+			Description:         "Optional values which, together with the base name, are hashed to produce a deterministic suffix which is appended to the name",
+			MarkdownDescription: "Optional values which, together with the base name, are hashed to produce a deterministic suffix which is appended to the name",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (r ResourceNameFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var resourceType, name string
+	var hashInputs []string
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &resourceType, &name, &hashInputs))
+
+	if response.Error != nil {
+		return
+	}
+
+	rule, ok := resourceNameRules[resourceType]
+	if !ok {
+		response.Error = function.NewArgumentFuncError(0, fmt.Sprintf("expected `resource_type` to be one of %q but got %q", resourceNameResourceTypes(), resourceType))
+		return
+	}
+
+	result, err := rule.generate(name, hashInputs)
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, result))
+}
+
+// generate sanitises and truncates the name to meet the naming rules, appending a hash suffix when hash inputs are
+// specified - and then validates the result using the validation function for the Resource
+func (rule resourceNameRule) generate(name string, hashInputs []string) (string, error) {
+	result := name
+	if rule.lowercase {
+		result = strings.ToLower(result)
+	}
+	result = rule.disallowedCharacters.ReplaceAllString(result, rule.separator)
+
+	if rule.separator != "" {
+		for strings.Contains(result, rule.separator+rule.separator) {
+			result = strings.ReplaceAll(result, rule.separator+rule.separator, rule.separator)
+		}
+	}
+
+	if rule.mustStartWithLetter {
+		result = strings.TrimLeftFunc(result, func(r rune) bool {
+			return (r < 'a' || r > 'z') && (r < 'A' || r > 'Z')
+		})
+	}
+	result = rule.trimSeparators(result)
+
+	suffix := ""
+	if len(hashInputs) > 0 {
+		hash := sha256.Sum256([]byte(strings.Join(append([]string{name}, hashInputs...), "/")))
+		suffix = hex.EncodeToString(hash[:])[:resourceNameHashSuffixLength]
+		if result != "" {
+			suffix = rule.separator + suffix
+		}
+	}
+
+	if maxLength := rule.maxLength - len(suffix); len(result) > maxLength {
+		result = rule.trimSeparators(result[:maxLength])
+	}
+	result += suffix
+
+	if _, errs := rule.validateFunc(result, "name"); len(errs) > 0 {
+		return "", fmt.Errorf("the generated name %q is not valid: %+v", result, errors.Join(errs...))
+	}
+
+	return result, nil
+}
+
+func (rule resourceNameRule) trimSeparators(input string) string {
+	if rule.separator == "" {
+		return input
+	}
+
+	return strings.Trim(input, rule.separator)
+}
+
+func resourceNameResourceTypes() []string {
+	resourceTypes := make([]string, 0, len(resourceNameRules))
+	for k := range resourceNameRules {
+		resourceTypes = append(resourceTypes, k)
+	}
+	sort.Strings(resourceTypes)
+	return resourceTypes
+}
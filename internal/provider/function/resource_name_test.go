@@ -0,0 +1,99 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestProviderFunctionResourceName_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+			"azurerm": func() (tfprotov5.ProviderServer, error) { // nolint:unparam
+				return framework.V5ProviderWithoutPluginSDK()(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceNameOutput(),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("storage_account", "myappstorage"),
+					acceptance.TestCheckOutput("storage_account_hashed", "myappstorageaccoun6829ba"),
+					acceptance.TestCheckOutput("key_vault", "my-vault-prod-b384a2"),
+					acceptance.TestCheckOutput("mssql_server", "prod-sql"),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionResourceName_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+			"azurerm": func() (tfprotov5.ProviderServer, error) { // nolint:unparam
+				return framework.V5ProviderWithoutPluginSDK()(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testResourceNameInvalid(),
+				ExpectError: regexp.MustCompile("is not valid"),
+			},
+		},
+	})
+}
+
+func testResourceNameOutput() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+output "storage_account" {
+  value = provider::azurerm::resource_name("azurerm_storage_account", "My App_Storage!")
+}
+
+output "storage_account_hashed" {
+  value = provider::azurerm::resource_name("azurerm_storage_account", "My App Storage Account For Production", "sub", "rg")
+}
+
+output "key_vault" {
+  value = provider::azurerm::resource_name("azurerm_key_vault", "1-my__vault--prod-", "x")
+}
+
+output "mssql_server" {
+  value = provider::azurerm::resource_name("azurerm_mssql_server", "Prod SQL")
+}
+`
+}
+
+func testResourceNameInvalid() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+output "test" {
+  value = provider::azurerm::resource_name("azurerm_key_vault", "a")
+}
+`
+}
@@ -0,0 +1,124 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+)
+
+const (
+	// azureSubnetReservedAddresses is the number of addresses Azure reserves within each Subnet - the network address,
+	// the default gateway, two addresses mapping the Azure DNS IPs and the broadcast address.
+	azureSubnetReservedAddresses = 5
+
+	// azureSubnetMaximumPrefixLength is the smallest Subnet which can be created within a Virtual Network
+	azureSubnetMaximumPrefixLength = 29
+)
+
+// parseSubnetCIDR parses an IPv4 CIDR block, validating it using the same rules as the `CIDR` validation function
+// used within the Provider and ensuring that the value is the network address for the CIDR block.
+func parseSubnetCIDR(input string, name string) (*net.IPNet, error) {
+	if _, errs := validate.CIDR(input, name); len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	if !strings.Contains(input, "/") {
+		return nil, fmt.Errorf("%s must include a prefix length, for example `10.0.1.0/24`. Got %q", name, input)
+	}
+
+	ip, network, err := net.ParseCIDR(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s %q: %+v", name, input, err)
+	}
+
+	if !ip.Equal(network.IP) {
+		return nil, fmt.Errorf("%s %q is not the network address of the CIDR block, did you mean %q?", name, input, network.String())
+	}
+
+	return network, nil
+}
+
+// subnetUsableHosts returns the number of addresses within the CIDR block which are available for use once the
+// addresses Azure reserves within each Subnet have been accounted for.
+func subnetUsableHosts(network *net.IPNet) (int64, error) {
+	prefixLength, bits := network.Mask.Size()
+	if prefixLength > azureSubnetMaximumPrefixLength {
+		return 0, fmt.Errorf("the smallest supported Subnet is a /%d but got a /%d", azureSubnetMaximumPrefixLength, prefixLength)
+	}
+
+	return int64(1)<<(bits-prefixLength) - azureSubnetReservedAddresses, nil
+}
+
+func ipv4ToUint32(ip net.IP) uint32 {
+	return binary.BigEndian.Uint32(ip.To4())
+}
+
+func uint32ToIPv4(v uint32) net.IP {
+	ip := make(net.IP, net.IPv4len)
+	binary.BigEndian.PutUint32(ip, v)
+	return ip
+}
+
+// cidrRange returns the first and last addresses within the CIDR block as integers
+func cidrRange(network *net.IPNet) (uint32, uint32) {
+	prefixLength, bits := network.Mask.Size()
+	first := ipv4ToUint32(network.IP)
+	return first, first + uint32(uint64(1)<<(bits-prefixLength)-1)
+}
+
+func cidrsOverlap(a, b *net.IPNet) bool {
+	aFirst, aLast := cidrRange(a)
+	bFirst, bLast := cidrRange(b)
+	return aFirst <= bLast && bFirst <= aLast
+}
+
+// nextFreeSubnet returns the first CIDR block with the specified prefix length within the address spaces which
+// doesn't overlap any of the existing Subnets.
+func nextFreeSubnet(addressSpaces []*net.IPNet, subnets []*net.IPNet, prefixLength int) (*net.IPNet, error) {
+	if prefixLength > azureSubnetMaximumPrefixLength {
+		return nil, fmt.Errorf("the smallest supported Subnet is a /%d but got a /%d", azureSubnetMaximumPrefixLength, prefixLength)
+	}
+
+	for _, addressSpace := range addressSpaces {
+		addressSpacePrefixLength, bits := addressSpace.Mask.Size()
+		if prefixLength < addressSpacePrefixLength {
+			continue
+		}
+
+		mask := net.CIDRMask(prefixLength, bits)
+		size := uint64(1) << (bits - prefixLength)
+		first, last := cidrRange(addressSpace)
+		candidate := uint64(first)
+		for candidate+size-1 <= uint64(last) {
+			network := &net.IPNet{
+				IP:   uint32ToIPv4(uint32(candidate)),
+				Mask: mask,
+			}
+
+			var overlapping *net.IPNet
+			for _, subnet := range subnets {
+				if cidrsOverlap(network, subnet) {
+					overlapping = subnet
+					break
+				}
+			}
+
+			if overlapping == nil {
+				return network, nil
+			}
+
+			// skip to the next aligned CIDR block after the overlapping Subnet
+			_, overlappingLast := cidrRange(overlapping)
+			candidate = (uint64(overlappingLast) + size) / size * size
+		}
+	}
+
+	return nil, fmt.Errorf("no free /%d CIDR block is available within the address space", prefixLength)
+}
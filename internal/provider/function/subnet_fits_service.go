@@ -0,0 +1,92 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// subnetServiceMinimumPrefixLengths maps the Subnet name required by each Azure Service to the smallest Subnet which
+// the Service supports
+var subnetServiceMinimumPrefixLengths = map[string]int{
+	"AzureBastionSubnet":            26,
+	"AzureFirewallManagementSubnet": 26,
+	"AzureFirewallSubnet":           26,
+	// whilst a /29 can be used for some VPN Gateways, a /27 is required for ExpressRoute and coexisting Gateways
+	"GatewaySubnet":     27,
+	"RouteServerSubnet": 27,
+}
+
+type SubnetFitsServiceFunction struct{}
+
+var _ function.Function = SubnetFitsServiceFunction{}
+
+func NewSubnetFitsServiceFunction() function.Function {
+	return &SubnetFitsServiceFunction{}
+}
+
+func (s SubnetFitsServiceFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "subnet_fits_service"
+}
+
+func (s SubnetFitsServiceFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "subnet_fits_service",
+		Description:         "Returns whether a Subnet is large enough to host the specified Azure Service",
+		MarkdownDescription: "Returns whether a Subnet is large enough to host the specified Azure Service",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr",
+				Description:         "The IPv4 CIDR block of the Subnet",
+				MarkdownDescription: "The IPv4 CIDR block of the Subnet",
+			},
+			function.StringParameter{
+				Name:                "service",
+				Description:         fmt.Sprintf("The name of the Subnet required by the Azure Service. Possible values are %s", strings.Join(subnetServiceNames(), ", ")),
+				MarkdownDescription: fmt.Sprintf("The name of the Subnet required by the Azure Service. Possible values are `%s`", strings.Join(subnetServiceNames(), "`, `")),
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (s SubnetFitsServiceFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var cidr, service string
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &cidr, &service))
+
+	if response.Error != nil {
+		return
+	}
+
+	network, err := parseSubnetCIDR(cidr, "cidr")
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	minimumPrefixLength, ok := subnetServiceMinimumPrefixLengths[service]
+	if !ok {
+		response.Error = function.NewArgumentFuncError(1, fmt.Sprintf("expected `service` to be one of %q but got %q", subnetServiceNames(), service))
+		return
+	}
+
+	prefixLength, _ := network.Mask.Size()
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, prefixLength <= minimumPrefixLength))
+}
+
+func subnetServiceNames() []string {
+	names := make([]string, 0, len(subnetServiceMinimumPrefixLengths))
+	for k := range subnetServiceMinimumPrefixLengths {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,94 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestProviderFunctionSubnetFitsService_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+			"azurerm": func() (tfprotov5.ProviderServer, error) { // nolint:unparam
+				return framework.V5ProviderWithoutPluginSDK()(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testSubnetFitsServiceOutput(),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("bastion_fits", "true"),
+					acceptance.TestCheckOutput("bastion_too_small", "false"),
+					acceptance.TestCheckOutput("gateway_fits", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionSubnetFitsService_unknownService(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+			"azurerm": func() (tfprotov5.ProviderServer, error) { // nolint:unparam
+				return framework.V5ProviderWithoutPluginSDK()(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testSubnetFitsServiceUnknownService(),
+				ExpectError: regexp.MustCompile("expected `service` to be one of"),
+			},
+		},
+	})
+}
+
+func testSubnetFitsServiceOutput() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+output "bastion_fits" {
+  value = provider::azurerm::subnet_fits_service("10.0.1.0/26", "AzureBastionSubnet")
+}
+
+output "bastion_too_small" {
+  value = provider::azurerm::subnet_fits_service("10.0.1.0/27", "AzureBastionSubnet")
+}
+
+output "gateway_fits" {
+  value = provider::azurerm::subnet_fits_service("10.0.2.0/27", "GatewaySubnet")
+}
+`
+}
+
+func testSubnetFitsServiceUnknownService() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+output "test" {
+  value = provider::azurerm::subnet_fits_service("10.0.1.0/26", "SomeOtherSubnet")
+}
+`
+}
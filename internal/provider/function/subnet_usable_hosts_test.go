@@ -0,0 +1,89 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestProviderFunctionSubnetUsableHosts_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+			"azurerm": func() (tfprotov5.ProviderServer, error) { // nolint:unparam
+				return framework.V5ProviderWithoutPluginSDK()(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testSubnetUsableHostsOutput(),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("slash_24", "251"),
+					acceptance.TestCheckOutput("slash_29", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionSubnetUsableHosts_tooSmall(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+			"azurerm": func() (tfprotov5.ProviderServer, error) { // nolint:unparam
+				return framework.V5ProviderWithoutPluginSDK()(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testSubnetUsableHostsTooSmall(),
+				ExpectError: regexp.MustCompile("the smallest supported Subnet is a /29"),
+			},
+		},
+	})
+}
+
+func testSubnetUsableHostsOutput() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+output "slash_24" {
+  value = provider::azurerm::subnet_usable_hosts("10.0.1.0/24")
+}
+
+output "slash_29" {
+  value = provider::azurerm::subnet_usable_hosts("10.0.2.0/29")
+}
+`
+}
+
+func testSubnetUsableHostsTooSmall() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+output "test" {
+  value = provider::azurerm::subnet_usable_hosts("10.0.2.0/30")
+}
+`
+}
@@ -0,0 +1,102 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type VnetNextFreeSubnetFunction struct{}
+
+var _ function.Function = VnetNextFreeSubnetFunction{}
+
+func NewVnetNextFreeSubnetFunction() function.Function {
+	return &VnetNextFreeSubnetFunction{}
+}
+
+func (v VnetNextFreeSubnetFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "vnet_next_free_subnet"
+}
+
+func (v VnetNextFreeSubnetFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "vnet_next_free_subnet",
+		Description:         "Returns the first CIDR block of the specified size within the address space of a Virtual Network which doesn't overlap any existing Subnets",
+		MarkdownDescription: "Returns the first CIDR block of the specified size within the address space of a Virtual Network which doesn't overlap any existing Subnets",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "address_space",
+				Description:         "The IPv4 CIDR blocks which make up the address space of the Virtual Network",
+				MarkdownDescription: "The IPv4 CIDR blocks which make up the address space of the Virtual Network",
+				ElementType:         types.StringType,
+			},
+			function.ListParameter{
+				Name:                "existing_subnets",
+				Description:         "The IPv4 CIDR blocks of the Subnets which already exist within the Virtual Network",
+				MarkdownDescription: "The IPv4 CIDR blocks of the Subnets which already exist within the Virtual Network",
+				ElementType:         types.StringType,
+			},
+			function.Int64Parameter{
+				Name:                "prefix_length",
+				Description:         "The prefix length of the new Subnet, for example 24 for a /24",
+				MarkdownDescription: "The prefix length of the new Subnet, for example `24` for a `/24`",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (v VnetNextFreeSubnetFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var addressSpace, existingSubnets []string
+	var prefixLength int64
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &addressSpace, &existingSubnets, &prefixLength))
+
+	if response.Error != nil {
+		return
+	}
+
+	if len(addressSpace) == 0 {
+		response.Error = function.NewArgumentFuncError(0, "at least one CIDR block must be specified for `address_space`")
+		return
+	}
+
+	addressSpaces := make([]*net.IPNet, 0, len(addressSpace))
+	for i, item := range addressSpace {
+		network, err := parseSubnetCIDR(item, fmt.Sprintf("address_space.%d", i))
+		if err != nil {
+			response.Error = function.NewArgumentFuncError(0, err.Error())
+			return
+		}
+		addressSpaces = append(addressSpaces, network)
+	}
+
+	subnets := make([]*net.IPNet, 0, len(existingSubnets))
+	for i, item := range existingSubnets {
+		network, err := parseSubnetCIDR(item, fmt.Sprintf("existing_subnets.%d", i))
+		if err != nil {
+			response.Error = function.NewArgumentFuncError(1, err.Error())
+			return
+		}
+		subnets = append(subnets, network)
+	}
+
+	if prefixLength < 0 || prefixLength > 32 {
+		response.Error = function.NewArgumentFuncError(2, fmt.Sprintf("expected `prefix_length` to be in the range (0 - 32) but got %d", prefixLength))
+		return
+	}
+
+	network, err := nextFreeSubnet(addressSpaces, subnets, int(prefixLength))
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(2, err.Error())
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, network.String()))
+}
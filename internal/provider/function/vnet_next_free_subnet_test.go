@@ -0,0 +1,94 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestProviderFunctionVnetNextFreeSubnet_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+			"azurerm": func() (tfprotov5.ProviderServer, error) { // nolint:unparam
+				return framework.V5ProviderWithoutPluginSDK()(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testVnetNextFreeSubnetOutput(),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("next_24", "10.0.3.0/24"),
+					acceptance.TestCheckOutput("next_26", "10.0.1.64/26"),
+					acceptance.TestCheckOutput("second_address_space", "10.1.0.0/27"),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionVnetNextFreeSubnet_full(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+			"azurerm": func() (tfprotov5.ProviderServer, error) { // nolint:unparam
+				return framework.V5ProviderWithoutPluginSDK()(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testVnetNextFreeSubnetFull(),
+				ExpectError: regexp.MustCompile("no free /27 CIDR block is available"),
+			},
+		},
+	})
+}
+
+func testVnetNextFreeSubnetOutput() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+output "next_24" {
+  value = provider::azurerm::vnet_next_free_subnet(["10.0.0.0/16"], ["10.0.0.0/24", "10.0.1.0/26", "10.0.2.0/24"], 24)
+}
+
+output "next_26" {
+  value = provider::azurerm::vnet_next_free_subnet(["10.0.0.0/16"], ["10.0.0.0/24", "10.0.1.0/26", "10.0.2.0/24"], 26)
+}
+
+output "second_address_space" {
+  value = provider::azurerm::vnet_next_free_subnet(["10.0.0.0/24", "10.1.0.0/24"], ["10.0.0.0/24"], 27)
+}
+`
+}
+
+func testVnetNextFreeSubnetFull() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+output "test" {
+  value = provider::azurerm::vnet_next_free_subnet(["10.0.0.0/24"], ["10.0.0.0/25", "10.0.0.128/25"], 27)
+}
+`
+}
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/sdk/auth"
 	"github.com/hashicorp/go-azure-sdk/sdk/environments"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -144,6 +145,18 @@ func azureProvider(supportLegacyTestSuite bool, testName string) *schema.Provide
 		}
 	}
 
+	for _, resource := range resources {
+		if supportsDefaultTags(resource) {
+			decorateResourceWithDefaultTags(resource)
+		}
+	}
+
+	for _, dataSource := range dataSources {
+		if supportsIgnoreTags(dataSource) {
+			decorateDataSourceWithIgnoreTags(dataSource)
+		}
+	}
+
 	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"subscription_id": {
@@ -341,6 +354,46 @@ func azureProvider(supportLegacyTestSuite bool, testName string) *schema.Provide
 
 			"features": schemaFeatures(supportLegacyTestSuite),
 
+			"default_tags": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				ValidateFunc: tags.Validate,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "A mapping of tags which should be assigned to all Resources managed by this Provider which support tags. Tags defined on a Resource take precedence over a default tag with the same key.",
+			},
+
+			"ignore_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Tags which are managed outside of Terraform and should be ignored when reading the tags assigned to Resources and Data Sources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"keys": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "A list of tag keys which should be ignored, compared case-insensitively.",
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"key_prefixes": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "A list of tag key prefixes, where tags with a matching key should be ignored, compared case-insensitively.",
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+			},
+
 			// Advanced feature flags
 			"resource_provider_registrations": {
 				Type:        schema.TypeString,
@@ -372,6 +425,21 @@ func azureProvider(supportLegacyTestSuite bool, testName string) *schema.Provide
 				DefaultFunc: schema.EnvDefaultFunc("ARM_STORAGE_USE_AZUREAD", false),
 				Description: "Should the AzureRM Provider use Azure AD Authentication when accessing the Storage Data Plane APIs?",
 			},
+
+			"max_requests_per_second": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_MAX_REQUESTS_PER_SECOND", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The maximum number of requests per second which should be made to Azure, shared across all clients. Defaults to `0`, meaning requests aren't rate limited.",
+			},
+
+			"throttling_retry": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_THROTTLING_RETRY", false),
+				Description: "Should requests to Azure Resource Manager be delayed when the remaining rate limit for the Subscription or Tenant is low, and be paused for all clients when a request is throttled?",
+			},
 		},
 
 		DataSourcesMap: dataSources,
@@ -519,9 +587,12 @@ func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData
 
 	clientBuilder := clients.ClientBuilder{
 		AuthConfig:                  authConfig,
+		DefaultTags:                 expandDefaultTags(d.Get("default_tags").(map[string]interface{})),
 		DisableCorrelationRequestID: d.Get("disable_correlation_request_id").(bool),
 		DisableTerraformPartnerID:   d.Get("disable_terraform_partner_id").(bool),
 		Features:                    features,
+		IgnoreTags:                  expandIgnoreTags(d.Get("ignore_tags").([]interface{})),
+		MaxRequestsPerSecond:        d.Get("max_requests_per_second").(int),
 		MetadataHost:                d.Get("metadata_host").(string),
 		PartnerID:                   d.Get("partner_id").(string),
 		RegisteredResourceProviders: requiredResourceProviders,
@@ -529,6 +600,7 @@ func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData
 		SubscriptionID:              d.Get("subscription_id").(string),
 		TerraformVersion:            p.TerraformVersion,
 		TestName:                    testName,
+		ThrottlingRetry:             d.Get("throttling_retry").(bool),
 
 		// this field is intentionally not exposed in the provider block, since it's only used for
 		// platform level tracing
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -44,6 +45,10 @@ type ResourceMetadata struct {
 	TimeoutUpdate *time.Duration
 
 	Features features.UserFeatures
+
+	// ListMaxConcurrency is the maximum number of concurrent requests a List Resource makes to retrieve the resources,
+	// which is set from the `max_concurrency` argument of the List Resource
+	ListMaxConcurrency int
 }
 
 // Defaults configures the Resource Metadata for client access, Provider Features, default timeouts, and subscriptionId.
@@ -174,6 +179,8 @@ func SetResponseErrorDiagnostic(resp any, summary string, detail any) {
 		v.Diagnostics.AddError(summary, errorMsg)
 	case *resource.ReadResponse:
 		v.Diagnostics.AddError(summary, errorMsg)
+	case *resource.ModifyPlanResponse:
+		v.Diagnostics.AddError(summary, errorMsg)
 	case *ephemeral.OpenResponse:
 		v.Diagnostics.AddError(summary, errorMsg)
 	case *ephemeral.RenewResponse:
@@ -325,6 +332,17 @@ type FrameworkWrappedResourceWithPlanModifier interface {
 	ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse, metadata ResourceMetadata)
 }
 
+// FrameworkWrappedResourceWithPreflight provides an interface for resources which support validating their planned
+// PUT payload using the Azure Preflight Validation API when the `preflight_enabled` Enhanced Validation feature is
+// enabled. This runs after ModifyPlan, if implemented.
+type FrameworkWrappedResourceWithPreflight interface {
+	FrameworkWrappedResource
+
+	// PreflightPayload builds the payload to validate from the plan, returning nil when validation should be skipped,
+	// for example when values required to build the payload are unknown
+	PreflightPayload(ctx context.Context, plan tfsdk.Plan, metadata ResourceMetadata) (*PreflightPayload, diag.Diagnostics)
+}
+
 type FrameworkWrappedResourceWithList interface {
 	FrameworkWrappedResource
 
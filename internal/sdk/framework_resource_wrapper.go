@@ -237,6 +237,10 @@ func (r *FrameworkResourceWrapper) ModifyPlan(ctx context.Context, request resou
 	if f, ok := r.FrameworkWrappedResource.(FrameworkWrappedResourceWithPlanModifier); ok {
 		f.ModifyPlan(ctx, request, response, r.ResourceMetadata)
 	}
+
+	if f, ok := r.FrameworkWrappedResource.(FrameworkWrappedResourceWithPreflight); ok && !response.Diagnostics.HasError() {
+		r.preflightModifyPlan(ctx, f, request, response)
+	}
 }
 
 func (r *FrameworkResourceWrapper) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, response *resource.IdentitySchemaResponse) {
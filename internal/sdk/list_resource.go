@@ -5,7 +5,7 @@ package sdk
 
 import (
 	"context"
-	"time"
+	"fmt"
 
 	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourcegroups"
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	terraformschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -37,6 +38,18 @@ func (r *FrameworkListResourceWrapper) Metadata(ctx context.Context, request res
 }
 
 func (r *FrameworkListResourceWrapper) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	r.wrappedListResourceConfigSchema(ctx, request, response)
+
+	if _, ok := r.FrameworkListWrappedResource.(FrameworkListWrappedResourceWithResourceGraph); ok {
+		response.Schema = resourceGraphListResourceConfigSchema(response.Schema)
+	}
+
+	response.Schema = listResourceOptionsConfigSchema(response.Schema)
+}
+
+// wrappedListResourceConfigSchema writes the configuration schema of the wrapped List Resource, without any of the
+// additions made by the wrapper, into `response`
+func (r *FrameworkListResourceWrapper) wrappedListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
 	if l, ok := r.FrameworkListWrappedResource.(FrameworkListWrappedResourceWithConfig); ok {
 		l.ListResourceConfigSchema(ctx, request, response)
 		return
@@ -66,10 +79,115 @@ func (r *FrameworkListResourceWrapper) ListResourceConfigSchema(ctx context.Cont
 }
 
 func (r *FrameworkListResourceWrapper) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
-	ctx, cancel := context.WithTimeout(ctx, time.Minute*60) // TODO - Custom Timeouts
+	options, diags := decodeListResourceOptions(ctx, request.Config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, options.Timeout)
 	defer cancel()
 
-	r.FrameworkListWrappedResource.List(ctx, request, stream, r.ResourceMetadata)
+	metadata := r.ResourceMetadata
+	metadata.ListMaxConcurrency = options.MaxConcurrency
+
+	if l, ok := r.FrameworkListWrappedResource.(FrameworkListWrappedResourceWithResourceGraph); ok {
+		r.listUsingResourceGraph(ctx, l, request, stream, metadata)
+		return
+	}
+
+	wrappedRequest, err := r.wrappedListRequest(ctx, request, nil)
+	if err != nil {
+		SetResponseErrorDiagnostic(stream, "building the configuration of the wrapped List Resource", err)
+		return
+	}
+
+	r.FrameworkListWrappedResource.List(ctx, wrappedRequest, stream, metadata)
+}
+
+// ListResultsConcurrently returns a stream of List Results which calls `hydrate` for each of `items` whilst streaming,
+// making at most `metadata.ListMaxConcurrency` calls concurrently, and pushes the results in the order of `items`.
+// `hydrate` pushes the result(s) for an item using the supplied `push` function, and the stream stops once a result
+// containing an error is pushed. This is intended for List Resources which make further requests to retrieve each of
+// the resources returned from the List API.
+func ListResultsConcurrently[T any](ctx context.Context, request list.ListRequest, metadata ResourceMetadata, items []T, hydrate func(ctx context.Context, item T, push func(list.ListResult) bool)) func(push func(list.ListResult) bool) {
+	deadline, hasDeadline := ctx.Deadline()
+
+	maxConcurrency := metadata.ListMaxConcurrency
+	if maxConcurrency < 1 {
+		maxConcurrency = 1
+	}
+
+	return func(push func(list.ListResult) bool) {
+		// the items are hydrated whilst streaming the results, so a new context is required
+		ctx, cancel := context.WithCancel(context.Background())
+		if hasDeadline {
+			ctx, cancel = context.WithDeadline(context.Background(), deadline)
+		}
+		defer cancel()
+
+		hydrated := make([]chan []list.ListResult, len(items))
+		for i := range hydrated {
+			hydrated[i] = make(chan []list.ListResult, 1)
+		}
+
+		go func() {
+			semaphore := make(chan struct{}, maxConcurrency)
+			for i, item := range items {
+				select {
+				case semaphore <- struct{}{}:
+				case <-ctx.Done():
+					return
+				}
+
+				go func() {
+					defer func() { <-semaphore }()
+
+					results := make([]list.ListResult, 0)
+					hydrate(ctx, item, func(result list.ListResult) bool {
+						results = append(results, result)
+						return true
+					})
+					hydrated[i] <- results
+				}()
+			}
+		}()
+
+		for i := range items {
+			var results []list.ListResult
+			select {
+			case results = <-hydrated[i]:
+			case <-ctx.Done():
+				SetErrorDiagnosticAndPushListResult(request.NewListResult(ctx), push, "listing resources", ctx.Err())
+				return
+			}
+
+			for _, result := range results {
+				if !push(result) || result.Diagnostics.HasError() {
+					return
+				}
+			}
+		}
+	}
+}
+
+// wrappedListRequest returns a copy of `request` whose configuration only contains the arguments defined by the
+// wrapped List Resource, so that it can be decoded into the model of the wrapped List Resource. The values of any
+// arguments in `overrides` are replaced.
+func (r *FrameworkListResourceWrapper) wrappedListRequest(ctx context.Context, request list.ListRequest, overrides map[string]tftypes.Value) (list.ListRequest, error) {
+	schemaResponse := list.ListResourceSchemaResponse{}
+	r.wrappedListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &schemaResponse)
+	if schemaResponse.Diagnostics.HasError() {
+		return request, fmt.Errorf("retrieving the configuration schema: %+v", schemaResponse.Diagnostics)
+	}
+
+	config, err := configForListResourceSchema(ctx, request.Config, schemaResponse.Schema, overrides)
+	if err != nil {
+		return request, err
+	}
+
+	request.Config = config
+	return request, nil
 }
 
 func (r *FrameworkListResourceWrapper) RawV5Schemas(ctx context.Context, _ list.RawV5SchemaRequest, response *list.RawV5SchemaResponse) {
@@ -0,0 +1,128 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	defaultListTimeout        = 60 * time.Minute
+	defaultListMaxConcurrency = 10
+)
+
+// listResourceOptions contains the options common to all List Resources, which are handled by the wrapper rather than
+// the wrapped List Resource
+type listResourceOptions struct {
+	// Timeout is the maximum duration of the List operation, including streaming the results
+	Timeout time.Duration
+
+	// MaxConcurrency is the maximum number of concurrent requests the wrapper makes to retrieve the resources
+	MaxConcurrency int
+}
+
+// listResourceOptionsConfigSchema adds the `max_concurrency` argument and the `timeouts` block to the configuration
+// schema of a List Resource
+func listResourceOptionsConfigSchema(input listschema.Schema) listschema.Schema {
+	attributes := make(map[string]listschema.Attribute)
+	for k, v := range input.Attributes {
+		attributes[k] = v
+	}
+
+	attributes["max_concurrency"] = listschema.Int64Attribute{
+		Optional: true,
+		Validators: []validator.Int64{
+			int64validator.Between(1, 50),
+		},
+	}
+
+	blocks := make(map[string]listschema.Block)
+	for k, v := range input.Blocks {
+		blocks[k] = v
+	}
+
+	blocks["timeouts"] = listschema.SingleNestedBlock{
+		Attributes: map[string]listschema.Attribute{
+			"list": listschema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validateListTimeout,
+					},
+				},
+			},
+		},
+	}
+
+	input.Attributes = attributes
+	input.Blocks = blocks
+	return input
+}
+
+func validateListTimeout(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	duration, err := time.ParseDuration(v)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("parsing %q as a duration: %+v", key, err))
+		return
+	}
+
+	if duration <= 0 {
+		errors = append(errors, fmt.Errorf("%q must be greater than zero", key))
+	}
+
+	return
+}
+
+func decodeListResourceOptions(ctx context.Context, config tfsdk.Config) (listResourceOptions, diag.Diagnostics) {
+	options := listResourceOptions{
+		Timeout:        defaultListTimeout,
+		MaxConcurrency: defaultListMaxConcurrency,
+	}
+
+	var timeouts types.Object
+	diags := config.GetAttribute(ctx, path.Root("timeouts"), &timeouts)
+	if diags.HasError() {
+		return options, diags
+	}
+
+	if !timeouts.IsNull() && !timeouts.IsUnknown() {
+		if v, ok := timeouts.Attributes()["list"].(types.String); ok && !v.IsNull() && !v.IsUnknown() {
+			duration, err := time.ParseDuration(v.ValueString())
+			if err != nil {
+				diags.AddAttributeError(path.Root("timeouts").AtName("list"), "parsing `list` timeout", err.Error())
+				return options, diags
+			}
+			options.Timeout = duration
+		}
+	}
+
+	var maxConcurrency types.Int64
+	diags.Append(config.GetAttribute(ctx, path.Root("max_concurrency"), &maxConcurrency)...)
+	if diags.HasError() {
+		return options, diags
+	}
+
+	if !maxConcurrency.IsNull() && !maxConcurrency.IsUnknown() {
+		options.MaxConcurrency = int(maxConcurrency.ValueInt64())
+	}
+
+	return options, diags
+}
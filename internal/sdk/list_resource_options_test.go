@@ -0,0 +1,103 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestValidateListTimeout(t *testing.T) {
+	cases := map[string]bool{
+		"30s":   true,
+		"2h45m": true,
+		"0s":    false,
+		"-1m":   false,
+		"10":    false,
+		"":      false,
+	}
+
+	for input, valid := range cases {
+		_, errors := validateListTimeout(input, "list")
+		if (len(errors) == 0) != valid {
+			t.Fatalf("expected %q to be valid %t, got errors: %+v", input, valid, errors)
+		}
+	}
+}
+
+func TestDecodeListResourceOptions(t *testing.T) {
+	ctx := context.Background()
+
+	timeoutsType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"list": tftypes.String}}
+
+	cases := []struct {
+		Name                   string
+		Timeouts               tftypes.Value
+		MaxConcurrency         tftypes.Value
+		ExpectedTimeout        time.Duration
+		ExpectedMaxConcurrency int
+	}{
+		{
+			Name:                   "defaults",
+			Timeouts:               tftypes.NewValue(timeoutsType, nil),
+			MaxConcurrency:         tftypes.NewValue(tftypes.Number, nil),
+			ExpectedTimeout:        defaultListTimeout,
+			ExpectedMaxConcurrency: defaultListMaxConcurrency,
+		},
+		{
+			Name: "custom timeout",
+			Timeouts: tftypes.NewValue(timeoutsType, map[string]tftypes.Value{
+				"list": tftypes.NewValue(tftypes.String, "2h"),
+			}),
+			MaxConcurrency:         tftypes.NewValue(tftypes.Number, nil),
+			ExpectedTimeout:        2 * time.Hour,
+			ExpectedMaxConcurrency: defaultListMaxConcurrency,
+		},
+		{
+			Name: "custom max concurrency",
+			Timeouts: tftypes.NewValue(timeoutsType, map[string]tftypes.Value{
+				"list": tftypes.NewValue(tftypes.String, nil),
+			}),
+			MaxConcurrency:         tftypes.NewValue(tftypes.Number, 25),
+			ExpectedTimeout:        defaultListTimeout,
+			ExpectedMaxConcurrency: 25,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			schema := listschema.Schema{
+				Attributes: map[string]listschema.Attribute{},
+			}
+			values := map[string]tftypes.Value{
+				"max_concurrency": tc.MaxConcurrency,
+				"timeouts":        tc.Timeouts,
+			}
+
+			schema = listResourceOptionsConfigSchema(schema)
+			config := tfsdk.Config{
+				Schema: schema,
+				Raw:    tftypes.NewValue(schema.Type().TerraformType(ctx), values),
+			}
+
+			options, diags := decodeListResourceOptions(ctx, config)
+			if diags.HasError() {
+				t.Fatalf("decoding options: %+v", diags)
+			}
+
+			if options.Timeout != tc.ExpectedTimeout {
+				t.Fatalf("expected the timeout to be %s but got %s", tc.ExpectedTimeout, options.Timeout)
+			}
+
+			if options.MaxConcurrency != tc.ExpectedMaxConcurrency {
+				t.Fatalf("expected the max concurrency to be %d but got %d", tc.ExpectedMaxConcurrency, options.MaxConcurrency)
+			}
+		})
+	}
+}
@@ -0,0 +1,464 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourcegroups"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	graphResources "github.com/hashicorp/go-azure-sdk/resource-manager/resourcegraph/2024-04-01/resources"
+	azureResourceGroups "github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/resourcegroups"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	sdkdiag "github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"golang.org/x/sync/errgroup"
+)
+
+// resourceGraphPageSize is the maximum number of rows requested from Resource Graph per page
+const resourceGraphPageSize int64 = 1000
+
+// FrameworkListWrappedResourceWithResourceGraph is an optional interface for List Resources whose resources can be
+// discovered through Azure Resource Graph. When implemented, the wrapper adds the `management_group_id` and `filter`
+// arguments to the configuration schema, makes `resource_group_name` optional and lists the resources across the
+// Subscription or Management Group using Resource Graph, falling back to the List method of the wrapped resource,
+// once per Resource Group, when Resource Graph is unavailable.
+type FrameworkListWrappedResourceWithResourceGraph interface {
+	FrameworkListWrappedResource
+
+	// ResourceGraphType returns the type of the resource as indexed by Azure Resource Graph,
+	// e.g. `microsoft.network/virtualnetworks`
+	ResourceGraphType() string
+
+	// ResourceId returns a new, empty, instance of the Resource ID type of the resource, which is used to parse and
+	// normalise the casing of the IDs returned by Resource Graph before the resource is read
+	ResourceId() resourceids.ResourceId
+}
+
+type resourceGraphListModel struct {
+	ResourceGroupName types.String
+	SubscriptionId    types.String
+	ManagementGroupId types.String
+	Filter            types.String
+}
+
+type resourceGraphListResult struct {
+	id   string
+	name string
+}
+
+func resourceGraphListResourceConfigSchema(input listschema.Schema) listschema.Schema {
+	attributes := make(map[string]listschema.Attribute)
+	for k, v := range input.Attributes {
+		attributes[k] = v
+	}
+
+	// Resource Graph can list across a Subscription or Management Group, so the Resource Group is always optional
+	if v, ok := attributes["resource_group_name"].(listschema.StringAttribute); ok {
+		v.Required = false
+		v.Optional = true
+		attributes["resource_group_name"] = v
+	} else {
+		attributes["resource_group_name"] = listschema.StringAttribute{
+			Optional: true,
+			Validators: []validator.String{
+				typehelpers.WrappedStringValidator{
+					Func: resourcegroups.ValidateName,
+				},
+			},
+		}
+	}
+
+	if _, ok := attributes["subscription_id"]; !ok {
+		attributes["subscription_id"] = listschema.StringAttribute{
+			Optional: true,
+			Validators: []validator.String{
+				typehelpers.WrappedStringValidator{
+					Func: validation.IsUUID,
+				},
+			},
+		}
+	}
+
+	attributes["management_group_id"] = listschema.StringAttribute{
+		Optional: true,
+		Validators: []validator.String{
+			typehelpers.WrappedStringValidator{
+				Func: commonids.ValidateManagementGroupID,
+			},
+			stringvalidator.ConflictsWith(path.MatchRoot("resource_group_name"), path.MatchRoot("subscription_id")),
+		},
+	}
+
+	attributes["filter"] = listschema.StringAttribute{
+		Optional: true,
+		Validators: []validator.String{
+			typehelpers.WrappedStringValidator{
+				Func: validateResourceGraphFilter,
+			},
+		},
+	}
+
+	input.Attributes = attributes
+	return input
+}
+
+// validateResourceGraphFilter validates that the filter is a single Resource Graph (KQL) predicate, such as
+// `tags.env == 'prod'`, which is appended to the query as a `where` clause
+func validateResourceGraphFilter(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if strings.TrimSpace(v) == "" {
+		errors = append(errors, fmt.Errorf("%q must not be empty", key))
+		return
+	}
+
+	var quote rune
+	for _, c := range v {
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '|' || c == ';':
+			errors = append(errors, fmt.Errorf("%q must be a single predicate and cannot contain `%c` outside of a quoted string", key, c))
+			return
+		}
+	}
+
+	if quote != 0 {
+		errors = append(errors, fmt.Errorf("%q contains an unterminated string", key))
+	}
+
+	return
+}
+
+func (r *FrameworkListResourceWrapper) listUsingResourceGraph(ctx context.Context, wrapped FrameworkListWrappedResourceWithResourceGraph, request list.ListRequest, stream *list.ListResultsStream, metadata ResourceMetadata) {
+	var data resourceGraphListModel
+	diags := diag.Diagnostics{}
+	diags.Append(request.Config.GetAttribute(ctx, path.Root("resource_group_name"), &data.ResourceGroupName)...)
+	diags.Append(request.Config.GetAttribute(ctx, path.Root("subscription_id"), &data.SubscriptionId)...)
+	diags.Append(request.Config.GetAttribute(ctx, path.Root("management_group_id"), &data.ManagementGroupId)...)
+	diags.Append(request.Config.GetAttribute(ctx, path.Root("filter"), &data.Filter)...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	if !data.SubscriptionId.IsNull() {
+		metadata.SubscriptionId = data.SubscriptionId.ValueString()
+	}
+
+	// listing the resources within a single Resource Group without a filter is served by the API of the resource itself
+	requiresResourceGraph := !data.ManagementGroupId.IsNull() || !data.Filter.IsNull()
+	if !requiresResourceGraph && !data.ResourceGroupName.IsNull() {
+		r.listPerResourceGroup(ctx, request, stream, metadata, data.ResourceGroupName.ValueString())
+		return
+	}
+
+	metadataResponse := resource.MetadataResponse{}
+	wrapped.Metadata(ctx, resource.MetadataRequest{}, &metadataResponse)
+
+	input := graphResources.QueryRequest{
+		Query: buildResourceGraphQuery(wrapped.ResourceGraphType(), data.ResourceGroupName.ValueString(), data.Filter.ValueString()),
+		Options: &graphResources.QueryRequestOptions{
+			ResultFormat: pointer.To(graphResources.ResultFormatObjectArray),
+			Top:          pointer.To(resourceGraphPageSize),
+		},
+	}
+
+	if !data.ManagementGroupId.IsNull() {
+		managementGroupId, err := commonids.ParseManagementGroupID(data.ManagementGroupId.ValueString())
+		if err != nil {
+			SetResponseErrorDiagnostic(stream, "parsing `management_group_id`", err)
+			return
+		}
+		input.ManagementGroups = pointer.To([]string{managementGroupId.GroupId})
+	} else {
+		input.Subscriptions = pointer.To([]string{metadata.SubscriptionId})
+	}
+
+	results, err := listUsingResourceGraphQuery(ctx, metadata.Client.Resource.ResourceGraphClient, input, request.Limit)
+	if err != nil {
+		if requiresResourceGraph {
+			SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s` using Resource Graph", metadataResponse.TypeName), err)
+			return
+		}
+
+		log.Printf("[WARN] listing `%s` using Resource Graph failed, falling back to listing per Resource Group: %+v", metadataResponse.TypeName, err)
+		r.listPerResourceGroup(ctx, request, stream, metadata, "")
+		return
+	}
+
+	stream.Results = ListResultsConcurrently(ctx, request, metadata, results, func(ctx context.Context, item resourceGraphListResult, push func(list.ListResult) bool) {
+		result := request.NewListResult(ctx)
+		result.DisplayName = item.name
+
+		read := readResourceGraphListResult(ctx, wrapped, metadata, item)
+		if read.err != nil {
+			SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("retrieving `%s` %q", metadataResponse.TypeName, item.id), read.err)
+			return
+		}
+
+		// the resource may have been deleted since it was indexed by Resource Graph
+		if read.resourceData == nil {
+			return
+		}
+
+		EncodeListResult(ctx, read.resourceData, &result)
+		push(result)
+	})
+}
+
+type resourceGraphReadResult struct {
+	resourceData *pluginsdk.ResourceData
+	err          error
+}
+
+// readResourceGraphListResult reads the resource returned by Resource Graph using the Read function of the resource
+func readResourceGraphListResult(ctx context.Context, wrapped FrameworkListWrappedResourceWithResourceGraph, metadata ResourceMetadata, item resourceGraphListResult) resourceGraphReadResult {
+	// the ID is parsed insensitively, since Resource Graph doesn't always return the casing expected by the resource
+	id := wrapped.ResourceId()
+	parsed, err := resourceids.NewParserFromResourceIdType(id).Parse(item.id, true)
+	if err != nil {
+		return resourceGraphReadResult{err: fmt.Errorf("parsing ID: %+v", err)}
+	}
+	if err := id.FromParseResult(*parsed); err != nil {
+		return resourceGraphReadResult{err: fmt.Errorf("parsing ID: %+v", err)}
+	}
+
+	res := wrapped.ResourceFunc()
+	state, diags := res.RefreshWithoutUpgrade(ctx, &terraform.InstanceState{ID: id.ID()}, metadata.Client)
+	if diags.HasError() {
+		return resourceGraphReadResult{err: sdkDiagnosticsError(diags)}
+	}
+
+	if state == nil {
+		return resourceGraphReadResult{}
+	}
+
+	return resourceGraphReadResult{
+		resourceData: res.Data(state),
+	}
+}
+
+// listPerResourceGroup lists using the List method of the wrapped resource. When no Resource Group is specified and
+// the wrapped resource requires one, each of the Resource Groups within the Subscription is listed, concurrently.
+func (r *FrameworkListResourceWrapper) listPerResourceGroup(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, metadata ResourceMetadata, resourceGroupName string) {
+	schemaResponse := list.ListResourceSchemaResponse{}
+	r.wrappedListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &schemaResponse)
+	if schemaResponse.Diagnostics.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(schemaResponse.Diagnostics)
+		return
+	}
+
+	resourceGroupNames := []string{resourceGroupName}
+	if v, ok := schemaResponse.Schema.Attributes["resource_group_name"]; ok && v.IsRequired() && resourceGroupName == "" {
+		resp, err := metadata.Client.Resource.ResourceGroupsClient.ListComplete(ctx, commonids.NewSubscriptionID(metadata.SubscriptionId), azureResourceGroups.DefaultListOperationOptions())
+		if err != nil {
+			SetResponseErrorDiagnostic(stream, "listing Resource Groups", err)
+			return
+		}
+
+		resourceGroupNames = make([]string, 0)
+		for _, item := range resp.Items {
+			resourceGroupNames = append(resourceGroupNames, pointer.From(item.Name))
+		}
+	}
+
+	requests := make([]list.ListRequest, 0)
+	for _, name := range resourceGroupNames {
+		overrides := make(map[string]tftypes.Value)
+		if name != "" {
+			overrides["resource_group_name"] = tftypes.NewValue(tftypes.String, name)
+		}
+
+		wrappedRequest, err := r.wrappedListRequest(ctx, request, overrides)
+		if err != nil {
+			SetResponseErrorDiagnostic(stream, "building the configuration of the wrapped List Resource", err)
+			return
+		}
+		requests = append(requests, wrappedRequest)
+	}
+
+	streams := make([]*list.ListResultsStream, len(requests))
+	group := errgroup.Group{}
+	group.SetLimit(metadata.ListMaxConcurrency)
+	for i, wrappedRequest := range requests {
+		streams[i] = &list.ListResultsStream{}
+		group.Go(func() error {
+			r.FrameworkListWrappedResource.List(ctx, wrappedRequest, streams[i], metadata)
+			return nil
+		})
+	}
+	_ = group.Wait()
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, s := range streams {
+			if s.Results == nil {
+				continue
+			}
+
+			stopped := false
+			s.Results(func(result list.ListResult) bool {
+				if !push(result) {
+					stopped = true
+				}
+				return !stopped
+			})
+
+			if stopped {
+				return
+			}
+		}
+	}
+}
+
+// configForListResourceSchema returns a copy of `config` containing only the attributes defined in `schema`, with the
+// values of any attributes in `overrides` replaced
+func configForListResourceSchema(ctx context.Context, config tfsdk.Config, schema listschema.Schema, overrides map[string]tftypes.Value) (tfsdk.Config, error) {
+	values := make(map[string]tftypes.Value)
+	if !config.Raw.IsNull() {
+		if err := config.Raw.As(&values); err != nil {
+			return tfsdk.Config{}, err
+		}
+	}
+
+	objectType, ok := schema.Type().TerraformType(ctx).(tftypes.Object)
+	if !ok {
+		return tfsdk.Config{}, fmt.Errorf("expected the schema to be an object, got %T", schema.Type().TerraformType(ctx))
+	}
+
+	output := make(map[string]tftypes.Value)
+	for name, attributeType := range objectType.AttributeTypes {
+		if v, ok := overrides[name]; ok {
+			output[name] = v
+			continue
+		}
+
+		if v, ok := values[name]; ok {
+			output[name] = v
+			continue
+		}
+
+		output[name] = tftypes.NewValue(attributeType, nil)
+	}
+
+	return tfsdk.Config{
+		Schema: schema,
+		Raw:    tftypes.NewValue(objectType, output),
+	}, nil
+}
+
+func buildResourceGraphQuery(resourceType string, resourceGroupName string, filter string) string {
+	clauses := []string{
+		"Resources",
+		fmt.Sprintf("where type =~ '%s'", resourceType),
+	}
+
+	if resourceGroupName != "" {
+		clauses = append(clauses, fmt.Sprintf("where resourceGroup =~ '%s'", resourceGroupName))
+	}
+
+	if filter != "" {
+		clauses = append(clauses, fmt.Sprintf("where (%s)", filter))
+	}
+
+	clauses = append(clauses, "project id, name", "order by id asc")
+
+	return strings.Join(clauses, " | ")
+}
+
+// listUsingResourceGraphQuery pages through the results of the Resource Graph query, stopping once `limit` results
+// have been retrieved when a limit is specified
+func listUsingResourceGraphQuery(ctx context.Context, client *graphResources.ResourcesClient, input graphResources.QueryRequest, limit int64) ([]resourceGraphListResult, error) {
+	if client == nil {
+		return nil, fmt.Errorf("the Resource Graph client has not been configured")
+	}
+
+	results := make([]resourceGraphListResult, 0)
+	for {
+		resp, err := client.Resources(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("querying Resource Graph: %+v", err)
+		}
+
+		if resp.Model == nil {
+			return nil, fmt.Errorf("querying Resource Graph: model was nil")
+		}
+
+		rows, ok := resp.Model.Data.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("querying Resource Graph: expected the data to be a list but got %T", resp.Model.Data)
+		}
+
+		for _, row := range rows {
+			v, ok := row.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			id, _ := v["id"].(string)
+			name, _ := v["name"].(string)
+			if id == "" {
+				continue
+			}
+
+			results = append(results, resourceGraphListResult{
+				id:   id,
+				name: name,
+			})
+		}
+
+		if limit > 0 && int64(len(results)) >= limit {
+			return results, nil
+		}
+
+		if pointer.From(resp.Model.SkipToken) == "" {
+			return results, nil
+		}
+
+		input.Options.SkipToken = resp.Model.SkipToken
+	}
+}
+
+func sdkDiagnosticsError(diags sdkdiag.Diagnostics) error {
+	messages := make([]string, 0)
+	for _, d := range diags {
+		if d.Severity != sdkdiag.Error {
+			continue
+		}
+
+		message := d.Summary
+		if d.Detail != "" && d.Detail != d.Summary {
+			message = fmt.Sprintf("%s: %s", d.Summary, d.Detail)
+		}
+		messages = append(messages, message)
+	}
+
+	return fmt.Errorf("%s", strings.Join(messages, "; "))
+}
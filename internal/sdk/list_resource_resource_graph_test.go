@@ -0,0 +1,163 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestValidateResourceGraphFilter(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "tags.env == 'prod'",
+			Valid: true,
+		},
+		{
+			Input: "name startswith 'prod-' and location =~ 'westeurope'",
+			Valid: true,
+		},
+		{
+			Input: "tags.owner == 'a|b;c'",
+			Valid: true,
+		},
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "tags.env == 'prod' | project id",
+			Valid: false,
+		},
+		{
+			Input: "tags.env == 'prod'; Resources",
+			Valid: false,
+		},
+		{
+			Input: "tags.env == 'prod",
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Input)
+
+		_, errors := validateResourceGraphFilter(tc.Input, "filter")
+		if valid := len(errors) == 0; valid != tc.Valid {
+			t.Fatalf("expected %q to be valid %t, got %t: %+v", tc.Input, tc.Valid, valid, errors)
+		}
+	}
+}
+
+func TestBuildResourceGraphQuery(t *testing.T) {
+	cases := []struct {
+		ResourceGroupName string
+		Filter            string
+		Expected          string
+	}{
+		{
+			Expected: "Resources | where type =~ 'microsoft.network/virtualnetworks' | project id, name | order by id asc",
+		},
+		{
+			ResourceGroupName: "example-rg",
+			Expected:          "Resources | where type =~ 'microsoft.network/virtualnetworks' | where resourceGroup =~ 'example-rg' | project id, name | order by id asc",
+		},
+		{
+			Filter:   "tags.env == 'prod'",
+			Expected: "Resources | where type =~ 'microsoft.network/virtualnetworks' | where (tags.env == 'prod') | project id, name | order by id asc",
+		},
+	}
+
+	for _, tc := range cases {
+		if actual := buildResourceGraphQuery("microsoft.network/virtualnetworks", tc.ResourceGroupName, tc.Filter); actual != tc.Expected {
+			t.Fatalf("expected %q but got %q", tc.Expected, actual)
+		}
+	}
+}
+
+func TestResourceGraphListResourceConfigSchema(t *testing.T) {
+	input := listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"resource_group_name": listschema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	actual := resourceGraphListResourceConfigSchema(input)
+
+	for _, name := range []string{"resource_group_name", "subscription_id", "management_group_id", "filter"} {
+		v, ok := actual.Attributes[name]
+		if !ok {
+			t.Fatalf("expected the attribute %q to be present", name)
+		}
+		if !v.IsOptional() || v.IsRequired() {
+			t.Fatalf("expected the attribute %q to be optional", name)
+		}
+	}
+
+	if !input.Attributes["resource_group_name"].IsRequired() {
+		t.Fatalf("expected the input schema to be unmodified")
+	}
+}
+
+func TestConfigForListResourceSchema(t *testing.T) {
+	ctx := context.Background()
+
+	fullSchema := listResourceOptionsConfigSchema(resourceGraphListResourceConfigSchema(listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"resource_group_name": listschema.StringAttribute{
+				Required: true,
+			},
+		},
+	}))
+	fullType := fullSchema.Type().TerraformType(ctx)
+	config := tfsdk.Config{
+		Schema: fullSchema,
+		Raw: tftypes.NewValue(fullType, map[string]tftypes.Value{
+			"resource_group_name": tftypes.NewValue(tftypes.String, nil),
+			"subscription_id":     tftypes.NewValue(tftypes.String, nil),
+			"management_group_id": tftypes.NewValue(tftypes.String, nil),
+			"max_concurrency":     tftypes.NewValue(tftypes.Number, 5),
+			"filter":              tftypes.NewValue(tftypes.String, "tags.env == 'prod'"),
+			"timeouts": tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"list": tftypes.String}}, map[string]tftypes.Value{
+				"list": tftypes.NewValue(tftypes.String, "2h"),
+			}),
+		}),
+	}
+
+	wrappedSchema := listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"resource_group_name": listschema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	actual, err := configForListResourceSchema(ctx, config, wrappedSchema, map[string]tftypes.Value{
+		"resource_group_name": tftypes.NewValue(tftypes.String, "example-rg"),
+	})
+	if err != nil {
+		t.Fatalf("building config: %+v", err)
+	}
+
+	var model struct {
+		ResourceGroupName types.String `tfsdk:"resource_group_name"`
+	}
+	if diags := actual.Get(ctx, &model); diags.HasError() {
+		t.Fatalf("decoding config: %+v", diags)
+	}
+
+	if model.ResourceGroupName.ValueString() != "example-rg" {
+		t.Fatalf("expected `resource_group_name` to be %q but got %q", "example-rg", model.ResourceGroupName.ValueString())
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+)
+
+func TestListResultsConcurrently(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	items := []int{5, 1, 4, 2, 3, 0}
+	metadata := ResourceMetadata{
+		ListMaxConcurrency: 2,
+	}
+
+	var running, maxRunning atomic.Int32
+	results := ListResultsConcurrently(ctx, list.ListRequest{}, metadata, items, func(ctx context.Context, item int, push func(list.ListResult) bool) {
+		current := running.Add(1)
+		defer running.Add(-1)
+		for {
+			v := maxRunning.Load()
+			if current <= v || maxRunning.CompareAndSwap(v, current) {
+				break
+			}
+		}
+
+		// later items complete first, to check that the results are pushed in order
+		time.Sleep(time.Duration(item) * 10 * time.Millisecond)
+
+		// items which don't exist push no results
+		if item == 0 {
+			return
+		}
+
+		push(list.ListResult{
+			DisplayName: fmt.Sprintf("item-%d", item),
+		})
+	})
+
+	actual := make([]string, 0)
+	results(func(result list.ListResult) bool {
+		actual = append(actual, result.DisplayName)
+		return true
+	})
+
+	expected := []string{"item-5", "item-1", "item-4", "item-2", "item-3"}
+	if fmt.Sprint(actual) != fmt.Sprint(expected) {
+		t.Fatalf("expected the results to be %v but got %v", expected, actual)
+	}
+
+	if v := maxRunning.Load(); v > 2 {
+		t.Fatalf("expected at most 2 items to be hydrated concurrently but got %d", v)
+	}
+}
+
+func TestListResultsConcurrentlyStopsOnError(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	items := []int{1, 2, 3, 4}
+	metadata := ResourceMetadata{
+		ListMaxConcurrency: 4,
+	}
+
+	results := ListResultsConcurrently(ctx, list.ListRequest{}, metadata, items, func(ctx context.Context, item int, push func(list.ListResult) bool) {
+		result := list.ListResult{
+			DisplayName: fmt.Sprintf("item-%d", item),
+		}
+		if item == 2 {
+			SetErrorDiagnosticAndPushListResult(result, push, "retrieving item", "example error")
+			return
+		}
+		push(result)
+	})
+
+	actual := make([]string, 0)
+	results(func(result list.ListResult) bool {
+		actual = append(actual, result.DisplayName)
+		return true
+	})
+
+	expected := []string{"item-1", "item-2"}
+	if fmt.Sprint(actual) != fmt.Sprint(expected) {
+		t.Fatalf("expected the results to be %v but got %v", expected, actual)
+	}
+}
@@ -171,6 +171,18 @@ type ResourceWithCustomizeDiff interface {
 	CustomizeDiff() ResourceFunc
 }
 
+// ResourceWithPreflight is an optional interface
+//
+// Resources implementing this interface will have the payload returned from PreflightPayload validated
+// using the Azure Preflight Validation API during `terraform plan`, when the `preflight_enabled`
+// Enhanced Validation feature is enabled. This runs after CustomizeDiff, if implemented.
+type ResourceWithPreflight interface {
+	Resource
+
+	// PreflightPayload returns a function which builds the PUT payload for this resource from the planned changes
+	PreflightPayload() PreflightPayloadFunc
+}
+
 // ResourceWithConfigValidation is an optional interface
 // Resources implementing this interface will have a write-only attribute that requires
 // this specific validation
@@ -0,0 +1,127 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	preflightClient "github.com/hashicorp/terraform-provider-azurerm/internal/preflight/client"
+)
+
+// preflightTimeout is the maximum duration of the preflight validation for a single resource, including
+// waiting for the other resources in the same batch
+const preflightTimeout = 5 * time.Minute
+
+// PreflightPayload is the resource to be validated using the Azure Preflight Validation API
+type PreflightPayload struct {
+	// Location is the Azure Region the resource will be created in
+	Location *string
+
+	// ResourceId is the ID of the resource, from which the scope, provider and resource type are derived
+	ResourceId resourceids.ResourceId
+
+	// ResourceTypeOverride optionally overrides the resource type derived from the ResourceId, for resource
+	// providers where the Preflight Validation API uses a different type discriminator, e.g. `redis`
+	ResourceTypeOverride string
+
+	// ApiVersion is the API Version of the payload
+	ApiVersion string
+
+	// Properties is the complete PUT payload for the resource, exactly as it will be sent to the ARM API.
+	// PATCH-style payloads are not supported, see internal/preflight/README.md for more information.
+	Properties any
+}
+
+// PreflightPayloadFunc builds the PreflightPayload from the ResourceDiff in the metadata, returning nil when
+// validation should be skipped, for example when values required to build the payload are unknown
+type PreflightPayloadFunc func(ctx context.Context, metadata ResourceMetaData) (*PreflightPayload, error)
+
+// preflightCustomizeDiff returns a CustomizeDiff function which validates the payload for the resource after
+// running the existing CustomizeDiff function (if any)
+func (rw *ResourceWrapper) preflightCustomizeDiff(v ResourceWithPreflight, customizeDiff schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if customizeDiff != nil {
+			if err := customizeDiff(ctx, d, meta); err != nil {
+				return err
+			}
+		}
+
+		client := meta.(*clients.Client)
+		if !client.Features.EnhancedValidation.PreflightEnabled {
+			return nil
+		}
+
+		// only validate new resources and those with changes, to avoid calling the API for every plan
+		if d.Id() != "" && len(d.GetChangedKeysPrefix("")) == 0 {
+			return nil
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, preflightTimeout)
+		defer cancel()
+		metaData := ResourceMetaData{
+			Client:                   client,
+			Logger:                   rw.logger,
+			ResourceDiff:             d,
+			serializationDebugLogger: NullLogger{},
+		}
+
+		payload, err := v.PreflightPayload()(ctx, metaData)
+		if err != nil {
+			return fmt.Errorf("building preflight validation payload: %+v", err)
+		}
+		if payload == nil {
+			return nil
+		}
+
+		return validatePreflightPayload(ctx, client, *payload)
+	}
+}
+
+// preflightModifyPlan validates the payload for a Framework resource using the plan from the ModifyPlan response,
+// so that any changes made by the resource's own ModifyPlan are included
+func (r *FrameworkResourceWrapper) preflightModifyPlan(ctx context.Context, f FrameworkWrappedResourceWithPreflight, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if r.Client == nil || !r.Features.EnhancedValidation.PreflightEnabled {
+		return
+	}
+
+	// the resource is being destroyed
+	if response.Plan.Raw.IsNull() {
+		return
+	}
+
+	// only validate new resources and those with changes, to avoid calling the API for every plan
+	if !request.State.Raw.IsNull() && request.State.Raw.Equal(response.Plan.Raw) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, preflightTimeout)
+	defer cancel()
+
+	payload, diags := f.PreflightPayload(ctx, response.Plan, r.ResourceMetadata)
+	response.Diagnostics.Append(diags...)
+	if diags.HasError() || payload == nil {
+		return
+	}
+
+	if err := validatePreflightPayload(ctx, r.Client, *payload); err != nil {
+		SetResponseErrorDiagnostic(response, "preflight validation failed", err)
+	}
+}
+
+// validatePreflightPayload validates the payload using the Azure Preflight Validation API, batching it with
+// other resources in the same scope which are being planned at the same time
+func validatePreflightPayload(ctx context.Context, client *clients.Client, payload PreflightPayload) error {
+	input, err := preflightClient.NewBatchRequest(payload.Location, payload.ResourceId, payload.ResourceTypeOverride, payload.ApiVersion, payload.Properties)
+	if err != nil {
+		return fmt.Errorf("constructing preflight validation request: %w", err)
+	}
+
+	return client.Preflight.ValidateInBatch(ctx, *input)
+}
@@ -152,6 +152,10 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 		}
 	}
 
+	if v, ok := rw.resource.(ResourceWithPreflight); ok {
+		resource.CustomizeDiff = rw.preflightCustomizeDiff(v, resource.CustomizeDiff)
+	}
+
 	if v, ok := rw.resource.(ResourceWithDeprecationAndNoReplacement); ok {
 		resource.DeprecationMessage = v.DeprecationMessage()
 	}
@@ -15,7 +15,6 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/virtualnetworks"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/appserviceenvironments"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/preflight"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -62,9 +61,9 @@ type AppServiceV3InboundDependencies struct {
 type AppServiceEnvironmentV3Resource struct{}
 
 var (
-	_ sdk.Resource                  = AppServiceEnvironmentV3Resource{}
-	_ sdk.ResourceWithUpdate        = AppServiceEnvironmentV3Resource{}
-	_ sdk.ResourceWithCustomizeDiff = AppServiceEnvironmentV3Resource{}
+	_ sdk.Resource              = AppServiceEnvironmentV3Resource{}
+	_ sdk.ResourceWithUpdate    = AppServiceEnvironmentV3Resource{}
+	_ sdk.ResourceWithPreflight = AppServiceEnvironmentV3Resource{}
 )
 
 func (r AppServiceEnvironmentV3Resource) Arguments() map[string]*pluginsdk.Schema {
@@ -353,44 +352,26 @@ func expandCreateForAppServiceEnvironmentV3(model AppServiceEnvironmentV3Model,
 	}
 }
 
-func (r AppServiceEnvironmentV3Resource) CustomizeDiff() sdk.ResourceFunc {
-	return sdk.ResourceFunc{
-		Timeout: 5 * time.Minute,
-		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
-			rd := metadata.ResourceDiff
-			if rd == nil {
-				return nil
-			}
-
-			// Only run preflight validation when it's enabled and the diff contains changes (or this is a new resource).
-			if metadata.Client.Features.EnhancedValidation.PreflightEnabled {
-				if len(rd.GetChangedKeysPrefix("")) > 0 || rd.Id() == "" {
-					var model AppServiceEnvironmentV3Model
-					if err := metadata.DecodeDiff(&model); err != nil {
-						return err
-					}
-
-					vnetLoc, skip := resolvePreflightVnetLocation(ctx, metadata, model)
-					if skip {
-						return nil
-					}
-
-					req := expandCreateForAppServiceEnvironmentV3(model, vnetLoc)
-					id := commonids.NewAppServiceEnvironmentID(metadata.Client.Account.SubscriptionId, model.ResourceGroup, model.Name)
-
-					preflightValidate, err := preflight.NewValidationRequest(pointer.To(vnetLoc), pointer.To(id), "2023-01-01", req)
-					if err != nil {
-						return fmt.Errorf("constructing preflight validation request: %w", err)
-					}
+func (r AppServiceEnvironmentV3Resource) PreflightPayload() sdk.PreflightPayloadFunc {
+	return func(ctx context.Context, metadata sdk.ResourceMetaData) (*sdk.PreflightPayload, error) {
+		var model AppServiceEnvironmentV3Model
+		if err := metadata.DecodeDiff(&model); err != nil {
+			return nil, err
+		}
 
-					if err = preflightValidate.ValidateResource(ctx, metadata); err != nil {
-						return err
-					}
-				}
-			}
+		vnetLoc, skip := resolvePreflightVnetLocation(ctx, metadata, model)
+		if skip {
+			return nil, nil
+		}
 
-			return nil
-		},
+		req := expandCreateForAppServiceEnvironmentV3(model, vnetLoc)
+		id := commonids.NewAppServiceEnvironmentID(metadata.Client.Account.SubscriptionId, model.ResourceGroup, model.Name)
+		return &sdk.PreflightPayload{
+			Location:   pointer.To(vnetLoc),
+			ResourceId: pointer.To(id),
+			ApiVersion: "2023-01-01",
+			Properties: req,
+		}, nil
 	}
 }
 
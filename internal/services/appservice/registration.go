@@ -70,7 +70,9 @@ func (r Registration) Resources() []sdk.Resource {
 
 func (r Registration) Actions() []func() action.Action {
 	return []func() action.Action{
+		newWebAppRestartAction,
 		newWebAppSetSlotDistributionAction,
+		newWebAppSlotSwapAction,
 	}
 }
 
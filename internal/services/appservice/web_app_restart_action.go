@@ -0,0 +1,208 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package appservice
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.Action = &webAppRestartAction{}
+
+type webAppRestartAction struct {
+	sdk.ActionMetadata
+}
+
+func newWebAppRestartAction() action.Action {
+	return &webAppRestartAction{}
+}
+
+type webAppRestartActionModel struct {
+	AppId       types.String `tfsdk:"app_id"`
+	SlotName    types.String `tfsdk:"slot_name"`
+	SoftRestart types.Bool   `tfsdk:"soft_restart"`
+	Timeout     types.String `tfsdk:"timeout"`
+}
+
+func (a *webAppRestartAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"app_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the Web App or Function App which should be restarted.",
+				MarkdownDescription: "The ID of the Web App or Function App which should be restarted.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: commonids.ValidateAppServiceID,
+					},
+				},
+			},
+
+			"slot_name": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The name of the Slot which should be restarted. Defaults to the production Slot.",
+				MarkdownDescription: "The name of the Slot which should be restarted. Defaults to the production Slot.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.StringIsNotEmpty,
+					},
+				},
+			},
+
+			"soft_restart": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Should a soft restart be performed? A soft restart applies the latest configuration and restarts the app processes without restarting the underlying workers. Defaults to `false`.",
+				MarkdownDescription: "Should a soft restart be performed? A soft restart applies the latest configuration and restarts the app processes without restarting the underlying workers. Defaults to `false`.",
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the action to complete. Defaults to `15m`.",
+				MarkdownDescription: "Timeout duration for the action to complete. Defaults to `15m`.",
+			},
+		},
+	}
+}
+
+func (a *webAppRestartAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_web_app_restart"
+}
+
+func (a *webAppRestartAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := a.Client.AppService.WebAppsClient
+
+	model := webAppRestartActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctxTimeout := 15 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+
+		ctxTimeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	id, err := commonids.ParseAppServiceID(model.AppId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	softRestart := model.SoftRestart.ValueBool()
+	restartType := "restart"
+	if softRestart {
+		restartType = "soft restart"
+	}
+
+	var refreshFunc pluginsdk.StateRefreshFunc
+	target := id.String()
+
+	if slotName := model.SlotName.ValueString(); slotName != "" {
+		slotId := webapps.NewSlotID(id.SubscriptionId, id.ResourceGroupName, id.SiteName, slotName)
+		target = slotId.String()
+
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("performing a %s of %s", restartType, target),
+		})
+
+		if _, err := client.RestartSlot(ctx, slotId, webapps.RestartSlotOperationOptions{
+			SoftRestart: pointer.To(softRestart),
+			// the restart is requested synchronously so that the action only returns once the app has been restarted
+			Synchronous: pointer.To(true),
+		}); err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("restarting %s: %+v", slotId, err))
+			return
+		}
+
+		refreshFunc = func() (interface{}, string, error) {
+			resp, err := client.GetSlot(ctx, slotId)
+			if err != nil {
+				return nil, "", fmt.Errorf("retrieving %s: %+v", slotId, err)
+			}
+			if resp.Model == nil || resp.Model.Properties == nil {
+				return nil, "", fmt.Errorf("retrieving %s: `properties` was nil", slotId)
+			}
+			return resp, pointer.From(resp.Model.Properties.State), nil
+		}
+	} else {
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("performing a %s of %s", restartType, target),
+		})
+
+		if _, err := client.Restart(ctx, *id, webapps.RestartOperationOptions{
+			SoftRestart: pointer.To(softRestart),
+			// the restart is requested synchronously so that the action only returns once the app has been restarted
+			Synchronous: pointer.To(true),
+		}); err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("restarting %s: %+v", id, err))
+			return
+		}
+
+		refreshFunc = func() (interface{}, string, error) {
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+			if resp.Model == nil || resp.Model.Properties == nil {
+				return nil, "", fmt.Errorf("retrieving %s: `properties` was nil", id)
+			}
+			return resp, pointer.From(resp.Model.Properties.State), nil
+		}
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		sdk.SetResponseErrorDiagnostic(response, "running action", "internal-error: context had no deadline")
+		return
+	}
+
+	// the state is compared case-insensitively since the API has been observed to return it in differing casing
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{"", "stopped", "starting", "restarting"},
+		Target:  []string{"running"},
+		Refresh: func() (interface{}, string, error) {
+			resp, state, err := refreshFunc()
+			return resp, strings.ToLower(state), err
+		},
+		MinTimeout:                5 * time.Second,
+		ContinuousTargetOccurence: 2,
+		Timeout:                   time.Until(deadline),
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("waiting for %s to be running: %+v", target, err))
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("%s of %s completed", restartType, target),
+	})
+}
+
+func (a *webAppRestartAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	a.Defaults(ctx, request, response)
+}
@@ -0,0 +1,104 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package appservice_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type WebAppRestartAction struct{}
+
+func TestAccWebAppRestartAction_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_app_restart", "test")
+	a := WebAppRestartAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: LinuxWebAppResource{}.basic(data),
+			},
+			{
+				Config: a.basic(data),
+			},
+		},
+	})
+}
+
+func TestAccWebAppRestartAction_softRestartSlot(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_app_restart", "test")
+	a := WebAppRestartAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: WebAppSetSlotDistributionAction{}.twoSlots(data),
+			},
+			{
+				Config: a.softRestartSlot(data),
+			},
+		},
+	})
+}
+
+func (a WebAppRestartAction) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+action "azurerm_web_app_restart" "test" {
+  config {
+    app_id = azurerm_linux_web_app.test.id
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = azurerm_linux_web_app.test.id
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_web_app_restart.test]
+    }
+  }
+}
+`, LinuxWebAppResource{}.basic(data))
+}
+
+func (a WebAppRestartAction) softRestartSlot(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+action "azurerm_web_app_restart" "test" {
+  config {
+    app_id       = azurerm_linux_web_app.test.id
+    slot_name    = azurerm_linux_web_app_slot.test1.name
+    soft_restart = true
+    timeout      = "10m"
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = azurerm_linux_web_app_slot.test1.id
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_web_app_restart.test]
+    }
+  }
+}
+`, WebAppSetSlotDistributionAction{}.twoSlots(data))
+}
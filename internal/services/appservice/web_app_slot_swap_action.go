@@ -0,0 +1,218 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package appservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+const (
+	webAppSlotSwapActionSwap            = "swap"
+	webAppSlotSwapActionSwapWithPreview = "swap_with_preview"
+	webAppSlotSwapActionCompleteSwap    = "complete_swap"
+	webAppSlotSwapActionCancelSwap      = "cancel_swap"
+
+	webAppSlotSwapProductionSlotName = "production"
+)
+
+var _ sdk.Action = &webAppSlotSwapAction{}
+
+type webAppSlotSwapAction struct {
+	sdk.ActionMetadata
+}
+
+func newWebAppSlotSwapAction() action.Action {
+	return &webAppSlotSwapAction{}
+}
+
+type webAppSlotSwapActionModel struct {
+	SlotId       types.String `tfsdk:"slot_id"`
+	TargetSlot   types.String `tfsdk:"target_slot"`
+	SwapAction   types.String `tfsdk:"swap_action"`
+	PreserveVnet types.Bool   `tfsdk:"preserve_vnet"`
+	Timeout      types.String `tfsdk:"timeout"`
+}
+
+func (a *webAppSlotSwapAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"slot_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the Web App or Function App Slot which should be swapped.",
+				MarkdownDescription: "The ID of the Web App or Function App Slot which should be swapped.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: webapps.ValidateSlotID,
+					},
+				},
+			},
+
+			"target_slot": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The name of the Slot which the Slot should be swapped with. Defaults to `production`.",
+				MarkdownDescription: "The name of the Slot which the Slot should be swapped with. Defaults to `production`.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.StringIsNotEmpty,
+					},
+				},
+			},
+
+			"swap_action": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The swap operation to perform. Possible values are `swap`, `swap_with_preview`, `complete_swap` and `cancel_swap`. Defaults to `swap`.",
+				MarkdownDescription: "The swap operation to perform. Possible values are `swap`, `swap_with_preview`, `complete_swap` and `cancel_swap`. Defaults to `swap`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						webAppSlotSwapActionSwap,
+						webAppSlotSwapActionSwapWithPreview,
+						webAppSlotSwapActionCompleteSwap,
+						webAppSlotSwapActionCancelSwap,
+					),
+				},
+			},
+
+			"preserve_vnet": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Should the Virtual Network configuration of the target Slot be preserved during the swap? Defaults to `true`.",
+				MarkdownDescription: "Should the Virtual Network configuration of the target Slot be preserved during the swap? Defaults to `true`.",
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the action to complete. Defaults to `30m`.",
+				MarkdownDescription: "Timeout duration for the action to complete. Defaults to `30m`.",
+			},
+		},
+	}
+}
+
+func (a *webAppSlotSwapAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_web_app_slot_swap"
+}
+
+func (a *webAppSlotSwapAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := a.Client.AppService.WebAppsClient
+
+	model := webAppSlotSwapActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctxTimeout := 30 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+
+		ctxTimeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	id, err := webapps.ParseSlotID(model.SlotId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+	appId := commonids.NewAppServiceID(id.SubscriptionId, id.ResourceGroupName, id.SiteName)
+
+	targetSlot := webAppSlotSwapProductionSlotName
+	if v := model.TargetSlot.ValueString(); v != "" {
+		targetSlot = v
+	}
+	if targetSlot == id.SlotName {
+		sdk.SetResponseErrorDiagnostic(response, "validating `target_slot`", fmt.Sprintf("the Slot %q cannot be swapped with itself", id.SlotName))
+		return
+	}
+	toProduction := targetSlot == webAppSlotSwapProductionSlotName
+
+	swapAction := webAppSlotSwapActionSwap
+	if v := model.SwapAction.ValueString(); v != "" {
+		swapAction = v
+	}
+
+	preserveVnet := true
+	if !model.PreserveVnet.IsNull() {
+		preserveVnet = model.PreserveVnet.ValueBool()
+	}
+
+	locks.ByID(appId.ID())
+	defer locks.UnlockByID(appId.ID())
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("invoking %s of Slot %q with Slot %q on %s", swapAction, id.SlotName, targetSlot, appId),
+	})
+
+	switch swapAction {
+	case webAppSlotSwapActionSwap, webAppSlotSwapActionCompleteSwap:
+		// completing a swap which is in preview is performed by swapping the Slots, which applies the
+		// remaining configuration and routes traffic to the swapped Slots
+		if toProduction {
+			input := webapps.CsmSlotEntity{
+				TargetSlot:   id.SlotName,
+				PreserveVnet: preserveVnet,
+			}
+			if err := client.SwapSlotWithProductionThenPoll(ctx, appId, input); err != nil {
+				sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("swapping %s with production: %+v", id, err))
+				return
+			}
+		} else {
+			input := webapps.CsmSlotEntity{
+				TargetSlot:   targetSlot,
+				PreserveVnet: preserveVnet,
+			}
+			if err := client.SwapSlotSlotThenPoll(ctx, *id, input); err != nil {
+				sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("swapping %s with the Slot %q: %+v", id, targetSlot, err))
+				return
+			}
+		}
+
+	case webAppSlotSwapActionSwapWithPreview:
+		// the first phase of a swap with preview applies the configuration of the target Slot to the source Slot,
+		// allowing the source Slot to be validated before the swap is completed or cancelled
+		input := webapps.CsmSlotEntity{
+			TargetSlot:   targetSlot,
+			PreserveVnet: preserveVnet,
+		}
+		if _, err := client.ApplySlotConfigurationSlot(ctx, *id, input); err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("applying the configuration of the Slot %q to %s: %+v", targetSlot, id, err))
+			return
+		}
+
+	case webAppSlotSwapActionCancelSwap:
+		// cancelling a swap with preview restores the configuration of the source Slot
+		if _, err := client.ResetSlotConfigurationSlot(ctx, *id); err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("cancelling the swap of %s with the Slot %q: %+v", id, targetSlot, err))
+			return
+		}
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("action %s of Slot %q with Slot %q on %s completed", swapAction, id.SlotName, targetSlot, appId),
+	})
+}
+
+func (a *webAppSlotSwapAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	a.Defaults(ctx, request, response)
+}
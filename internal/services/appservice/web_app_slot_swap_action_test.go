@@ -0,0 +1,298 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package appservice_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type WebAppSlotSwapAction struct{}
+
+func TestAccWebAppSlotSwapAction_swap(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_app_slot_swap", "test")
+	a := WebAppSlotSwapAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: WebAppSetSlotDistributionAction{}.twoSlots(data),
+			},
+			{
+				Config: a.swap(data, "swap"),
+				Check:  data.CheckWithClientForResource(a.checkSwappedWithProduction(fmt.Sprintf("acctestWAS-1-%d", data.RandomInteger)), "azurerm_linux_web_app.test"),
+			},
+		},
+	})
+}
+
+func TestAccWebAppSlotSwapAction_swapWithPreview(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_app_slot_swap", "test")
+	a := WebAppSlotSwapAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: WebAppSetSlotDistributionAction{}.twoSlots(data),
+			},
+			{
+				Config: a.swap(data, "swap_with_preview"),
+			},
+			{
+				Config: a.swap(data, "cancel_swap"),
+			},
+			{
+				Config: a.swap(data, "swap_with_preview"),
+			},
+			{
+				Config: a.swap(data, "complete_swap"),
+				Check:  data.CheckWithClientForResource(a.checkSwappedWithProduction(fmt.Sprintf("acctestWAS-1-%d", data.RandomInteger)), "azurerm_linux_web_app.test"),
+			},
+		},
+	})
+}
+
+func TestAccWebAppSlotSwapAction_swapWithPreviewAppliesTargetConfiguration(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_app_slot_swap", "test")
+	a := WebAppSlotSwapAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: a.stickySettings(data),
+			},
+			{
+				// the Slot Setting of the target (production) Slot should be applied to the source Slot
+				Config: a.swapStickySettings(data, "swap_with_preview"),
+				Check:  data.CheckWithClientForResource(a.checkSlotAppSetting("STICKY_SETTING", pointer.To("production")), "azurerm_linux_web_app_slot.test1"),
+			},
+			{
+				Config: a.swapStickySettings(data, "cancel_swap"),
+				Check:  data.CheckWithClientForResource(a.checkSlotAppSetting("STICKY_SETTING", nil), "azurerm_linux_web_app_slot.test1"),
+			},
+		},
+	})
+}
+
+func TestAccWebAppSlotSwapAction_targetSlot(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_app_slot_swap", "test")
+	a := WebAppSlotSwapAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: WebAppSetSlotDistributionAction{}.twoSlots(data),
+			},
+			{
+				Config: a.targetSlot(data),
+			},
+		},
+	})
+}
+
+func (WebAppSlotSwapAction) checkSwappedWithProduction(slotName string) acceptance.ClientCheckFunc {
+	return func(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) error {
+		id, err := commonids.ParseWebAppID(state.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := clients.AppService.WebAppsClient.Get(ctx, *id)
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		if resp.Model == nil || resp.Model.Properties == nil || resp.Model.Properties.SlotSwapStatus == nil {
+			return fmt.Errorf("no slot swap status found for %s", id)
+		}
+
+		if sourceSlotName := pointer.From(resp.Model.Properties.SlotSwapStatus.SourceSlotName); !strings.EqualFold(sourceSlotName, slotName) {
+			return fmt.Errorf("expected %s to have been swapped with the Slot %q but got %q", id, slotName, sourceSlotName)
+		}
+
+		return nil
+	}
+}
+
+func (WebAppSlotSwapAction) checkSlotAppSetting(name string, expected *string) acceptance.ClientCheckFunc {
+	return func(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) error {
+		id, err := webapps.ParseSlotID(state.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := clients.AppService.WebAppsClient.ListApplicationSettingsSlot(ctx, *id)
+		if err != nil {
+			return fmt.Errorf("listing the App Settings for %s: %+v", id, err)
+		}
+
+		var actual *string
+		if resp.Model != nil && resp.Model.Properties != nil {
+			if v, ok := (*resp.Model.Properties)[name]; ok {
+				actual = pointer.To(v)
+			}
+		}
+
+		if expected == nil {
+			if actual != nil {
+				return fmt.Errorf("expected the App Setting %q not to be set on %s but got %q", name, id, *actual)
+			}
+			return nil
+		}
+
+		if actual == nil || *actual != *expected {
+			return fmt.Errorf("expected the App Setting %q on %s to be %q but got %q", name, id, *expected, pointer.From(actual))
+		}
+
+		return nil
+	}
+}
+
+func (WebAppSlotSwapAction) swap(data acceptance.TestData, swapAction string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "terraform_data" "trigger" {
+  input = "%[2]s"
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.azurerm_web_app_slot_swap.test]
+    }
+  }
+}
+
+action "azurerm_web_app_slot_swap" "test" {
+  config {
+    slot_id     = azurerm_linux_web_app_slot.test1.id
+    swap_action = "%[2]s"
+  }
+}
+`, WebAppSetSlotDistributionAction{}.twoSlots(data), swapAction)
+}
+
+func (WebAppSlotSwapAction) targetSlot(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "terraform_data" "trigger" {
+  input = "swap"
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_web_app_slot_swap.test]
+    }
+  }
+}
+
+action "azurerm_web_app_slot_swap" "test" {
+  config {
+    slot_id       = azurerm_linux_web_app_slot.test1.id
+    target_slot   = azurerm_linux_web_app_slot.test2.name
+    preserve_vnet = false
+  }
+}
+`, WebAppSetSlotDistributionAction{}.twoSlots(data))
+}
+
+func (WebAppSlotSwapAction) swapStickySettings(data acceptance.TestData, swapAction string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "terraform_data" "trigger" {
+  input = "%[2]s"
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.azurerm_web_app_slot_swap.test]
+    }
+  }
+}
+
+action "azurerm_web_app_slot_swap" "test" {
+  config {
+    slot_id     = azurerm_linux_web_app_slot.test1.id
+    swap_action = "%[2]s"
+  }
+}
+`, WebAppSlotSwapAction{}.stickySettings(data), swapAction)
+}
+
+func (WebAppSlotSwapAction) stickySettings(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-slotswap-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_service_plan" "test" {
+  name                = "acctestASP-WAS-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  os_type             = "Linux"
+  sku_name            = "S1"
+}
+
+resource "azurerm_linux_web_app" "test" {
+  name                = "acctestWA-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  service_plan_id     = azurerm_service_plan.test.id
+
+  app_settings = {
+    STICKY_SETTING = "production"
+  }
+
+  sticky_settings {
+    app_setting_names = ["STICKY_SETTING"]
+  }
+
+  site_config {}
+}
+
+resource "azurerm_linux_web_app_slot" "test1" {
+  name           = "acctestWAS-1-%[1]d"
+  app_service_id = azurerm_linux_web_app.test.id
+
+  site_config {}
+
+  lifecycle {
+    # the App Settings of the Slot are modified by the swap with preview
+    ignore_changes = [app_settings]
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -0,0 +1,127 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package authorization
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/validate"
+	"golang.org/x/oauth2"
+)
+
+var _ sdk.EphemeralResource = &AccessTokenEphemeralResource{}
+
+func NewAccessTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &AccessTokenEphemeralResource{}
+}
+
+type AccessTokenEphemeralResource struct {
+	sdk.EphemeralResourceMetadata
+}
+
+type AccessTokenEphemeralResourceModel struct {
+	Scope     types.String `tfsdk:"scope"`
+	Token     types.String `tfsdk:"token"`
+	ExpiresOn types.String `tfsdk:"expires_on"`
+}
+
+func (e *AccessTokenEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "azurerm_access_token"
+}
+
+func (e *AccessTokenEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	e.Defaults(req, resp)
+}
+
+func (e *AccessTokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"scope": schema.StringAttribute{
+				Required:    true,
+				Description: "The `.default` scope of the API for which the Access Token should be issued, for example `https://management.azure.com/.default`.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validate.AccessTokenScope,
+					},
+				},
+			},
+
+			"token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"expires_on": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (e *AccessTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	ctx, cancel := context.WithTimeout(ctx, time.Minute*5)
+	defer cancel()
+
+	var data AccessTokenEphemeralResourceModel
+
+	if ok := e.DecodeOpen(ctx, req, resp, &data); !ok {
+		return
+	}
+
+	token, err := e.accessToken(ctx, data.Scope.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("obtaining an Access Token for the scope %q", data.Scope.ValueString()), err)
+		return
+	}
+
+	// NOTE: the value of an Ephemeral Resource can't change once opened, so the Access Token isn't renewed - instead
+	// the expiry is exposed so that it's clear how long the Access Token can be used for
+	data.Token = types.StringValue(token.AccessToken)
+	if !token.Expiry.IsZero() {
+		data.ExpiresOn = types.StringValue(token.Expiry.UTC().Format(time.RFC3339))
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (e *AccessTokenEphemeralResource) accessToken(ctx context.Context, scope string) (*oauth2.Token, error) {
+	if e.Client.AuthorizerFunc == nil {
+		return nil, errors.New("the Provider has not been configured with an Authorizer")
+	}
+
+	// the authorizers request the `.default` scope for the resource identifier of an API, the scope is validated to
+	// end with `/.default` so that a token isn't issued for a different audience
+	resource, ok := strings.CutSuffix(scope, "/.default")
+	if !ok {
+		return nil, fmt.Errorf("expected the scope %q to end with `/.default`", scope)
+	}
+	api := environments.NewApiEndpoint("AccessToken", resource, nil).WithResourceIdentifier(resource)
+
+	authorizer, err := e.Client.AuthorizerFunc(api)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := authorizer.Token(ctx, &http.Request{})
+	if err != nil {
+		return nil, err
+	}
+	if token == nil || token.AccessToken == "" {
+		return nil, errors.New("an empty Access Token was returned")
+	}
+
+	return token, nil
+}
@@ -79,7 +79,9 @@ func (r Registration) FrameworkDataSources() []sdk.FrameworkWrappedDataSource {
 }
 
 func (r Registration) EphemeralResources() []func() ephemeral.EphemeralResource {
-	return []func() ephemeral.EphemeralResource{}
+	return []func() ephemeral.EphemeralResource{
+		NewAccessTokenEphemeralResource,
+	}
 }
 
 func (r Registration) ListResources() []sdk.FrameworkListWrappedResource {
@@ -0,0 +1,31 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AccessTokenScope validates that the scope is the `.default` scope for an API, since the Provider's authorizers
+// request tokens for the resource identifier of an API rather than for individual (delegated) scopes
+func AccessTokenScope(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	resource, found := strings.CutSuffix(v, "/.default")
+	if !found {
+		errors = append(errors, fmt.Errorf("expected %q to end with `/.default`, for example `https://management.azure.com/.default`, got %q", key, v))
+		return
+	}
+
+	if strings.TrimSpace(resource) == "" || strings.TrimSpace(resource) != resource {
+		errors = append(errors, fmt.Errorf("expected %q to contain the resource identifier of an API before `/.default`, got %q", key, v))
+	}
+
+	return
+}
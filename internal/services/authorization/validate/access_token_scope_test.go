@@ -0,0 +1,56 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import "testing"
+
+func TestAccessTokenScope(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "/.default",
+			Valid: false,
+		},
+		{
+			Input: "https://management.azure.com",
+			Valid: false,
+		},
+		{
+			Input: "api://example/user_impersonation",
+			Valid: false,
+		},
+		{
+			Input: " https://management.azure.com/.default",
+			Valid: false,
+		},
+		{
+			Input: "https://management.azure.com/.default",
+			Valid: true,
+		},
+		{
+			Input: "6dae42f8-4368-4678-94ff-3960e28e3630/.default",
+			Valid: true,
+		},
+		{
+			Input: "api://example/.default",
+			Valid: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Input)
+		_, errors := AccessTokenScope(tc.Input, "scope")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t for %q", tc.Valid, valid, tc.Input)
+		}
+	}
+}
@@ -3,7 +3,6 @@ package automation
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
@@ -64,48 +63,37 @@ func (r AutomationAccountListResource) List(ctx context.Context, request list.Li
 		results = resp.Items
 	}
 
-	stream.Results = func(push func(list.ListResult) bool) {
-		ctx, cancel := context.WithTimeout(context.Background(), time.Minute*5)
-		defer cancel()
-		for _, account := range results {
-			result := request.NewListResult(ctx)
-			result.DisplayName = pointer.From(account.Name)
+	stream.Results = sdk.ListResultsConcurrently(ctx, request, metadata, results, func(ctx context.Context, account automationaccount.AutomationAccount, push func(list.ListResult) bool) {
+		result := request.NewListResult(ctx)
+		result.DisplayName = pointer.From(account.Name)
 
-			id, err := automationaccount.ParseAutomationAccountID(pointer.From(account.Id))
-			if err != nil {
-				sdk.SetErrorDiagnosticAndPushListResult(result, push, "parsing Automation Account ID", err)
-				return
-			}
-
-			rd := resourceAutomationAccount().Data(&terraform.InstanceState{})
-			rd.SetId(id.ID())
-
-			var registration *agentregistrationinformation.AgentRegistration
+		id, err := automationaccount.ParseAutomationAccountID(pointer.From(account.Id))
+		if err != nil {
+			sdk.SetErrorDiagnosticAndPushListResult(result, push, "parsing Automation Account ID", err)
+			return
+		}
 
-			if request.IncludeResource {
-				infoId := agentregistrationinformation.NewAutomationAccountID(id.SubscriptionId, id.ResourceGroupName, id.AutomationAccountName)
-				if keysResp, err := metadata.Client.Automation.AgentRegistrationInfoClient.Get(ctx, infoId); err != nil {
-					sdk.SetErrorDiagnosticAndPushListResult(result, push, "retrieving Automation Account Agent Registration Information", err)
-					return
-				} else {
-					registration = keysResp.Model
-				}
-			}
+		rd := resourceAutomationAccount().Data(&terraform.InstanceState{})
+		rd.SetId(id.ID())
 
-			if err := resourceAutomationAccountFlatten(rd, id, &account, registration); err != nil {
-				sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("encoding `%s` resource data", "azurerm_automation_account"), err)
-				return
-			}
+		var registration *agentregistrationinformation.AgentRegistration
 
-			sdk.EncodeListResult(ctx, rd, &result)
-			if result.Diagnostics.HasError() {
-				push(result)
+		if request.IncludeResource {
+			infoId := agentregistrationinformation.NewAutomationAccountID(id.SubscriptionId, id.ResourceGroupName, id.AutomationAccountName)
+			if keysResp, err := metadata.Client.Automation.AgentRegistrationInfoClient.Get(ctx, infoId); err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, "retrieving Automation Account Agent Registration Information", err)
 				return
+			} else {
+				registration = keysResp.Model
 			}
+		}
 
-			if !push(result) {
-				return
-			}
+		if err := resourceAutomationAccountFlatten(rd, id, &account, registration); err != nil {
+			sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("encoding `%s` resource data", "azurerm_automation_account"), err)
+			return
 		}
-	}
+
+		sdk.EncodeListResult(ctx, rd, &result)
+		push(result)
+	})
 }
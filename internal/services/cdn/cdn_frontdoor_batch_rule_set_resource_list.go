@@ -75,52 +75,34 @@ func (CdnFrontDoorBatchRuleSetListResource) List(ctx context.Context, request li
 		return
 	}
 
-	deadline, ok := ctx.Deadline()
-	if !ok {
-		sdk.SetResponseErrorDiagnostic(stream, "internal-error", "context had no deadline")
-		return
-	}
+	stream.Results = sdk.ListResultsConcurrently(ctx, request, metadata, resp.Items, func(ctx context.Context, item rulesets.RuleSet, push func(list.ListResult) bool) {
+		result := request.NewListResult(ctx)
+		result.DisplayName = pointer.From(item.Name)
+
+		ruleSetID, err := rulesets.ParseRuleSetIDInsensitively(pointer.From(item.Id))
+		if err != nil {
+			sdk.SetErrorDiagnosticAndPushListResult(result, push, "parsing Rule Set ID", err)
+			return
+		}
 
-	stream.Results = func(push func(list.ListResult) bool) {
-		ctx, cancel := context.WithDeadline(context.Background(), deadline)
-		defer cancel()
-
-		for _, item := range resp.Items {
-			result := request.NewListResult(ctx)
-			result.DisplayName = pointer.From(item.Name)
-
-			ruleSetID, err := rulesets.ParseRuleSetIDInsensitively(pointer.From(item.Id))
-			if err != nil {
-				sdk.SetErrorDiagnosticAndPushListResult(result, push, "parsing Rule Set ID", err)
-				return
-			}
-
-			detailedResp, err := client.Get(ctx, *ruleSetID)
-			if err != nil {
-				sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("retrieving `%s`", r.ResourceType()), err)
-				return
-			}
-			if detailedResp.Model == nil || detailedResp.Model.Properties == nil || detailedResp.Model.Properties.BatchMode == nil || !*detailedResp.Model.Properties.BatchMode {
-				continue
-			}
-
-			rmd := sdk.NewResourceMetaData(metadata.Client, r)
-			rmd.ResourceData.SetId(ruleSetID.ID())
-
-			if err := r.flatten(rmd, ruleSetID, detailedResp.Model); err != nil {
-				sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("encoding `%s` resource data", r.ResourceType()), err)
-				return
-			}
-
-			sdk.EncodeListResult(ctx, rmd.ResourceData, &result)
-			if result.Diagnostics.HasError() {
-				push(result)
-				return
-			}
-
-			if !push(result) {
-				return
-			}
+		detailedResp, err := client.Get(ctx, *ruleSetID)
+		if err != nil {
+			sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("retrieving `%s`", r.ResourceType()), err)
+			return
 		}
-	}
+		if detailedResp.Model == nil || detailedResp.Model.Properties == nil || detailedResp.Model.Properties.BatchMode == nil || !*detailedResp.Model.Properties.BatchMode {
+			return
+		}
+
+		rmd := sdk.NewResourceMetaData(metadata.Client, r)
+		rmd.ResourceData.SetId(ruleSetID.ID())
+
+		if err := r.flatten(rmd, ruleSetID, detailedResp.Model); err != nil {
+			sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("encoding `%s` resource data", r.ResourceType()), err)
+			return
+		}
+
+		sdk.EncodeListResult(ctx, rmd.ResourceData, &result)
+		push(result)
+	})
 }
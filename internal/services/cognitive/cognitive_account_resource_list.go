@@ -64,42 +64,25 @@ func (r CognitiveAccountListResource) List(ctx context.Context, request list.Lis
 
 		results = resp.Items
 	}
-	deadline, ok := ctx.Deadline()
-	if !ok {
-		sdk.SetResponseErrorDiagnostic(stream, "internal-error", "context had no deadline")
-		return
-	}
-	stream.Results = func(push func(list.ListResult) bool) {
-		ctx, cancel := context.WithDeadline(context.Background(), deadline)
-		defer cancel()
-
-		for _, account := range results {
-			result := request.NewListResult(ctx)
-			result.DisplayName = pointer.From(account.Name)
-
-			rd := resourceCognitiveAccount().Data(&terraform.InstanceState{})
-
-			id, err := cognitiveservicesaccounts.ParseAccountID(pointer.From(account.Id))
-			if err != nil {
-				sdk.SetErrorDiagnosticAndPushListResult(result, push, "parsing Cognitive Account ID", err)
-				return
-			}
-			rd.SetId(id.ID())
-
-			if err := resourceCognitiveAccountFlatten(ctx, client, rd, id, &account, request.IncludeResource); err != nil {
-				sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("encoding `%s` resource data", azureCognitiveAccountResourceName), err)
-				return
-			}
-
-			sdk.EncodeListResult(ctx, rd, &result)
-			if result.Diagnostics.HasError() {
-				push(result)
-				return
-			}
-
-			if !push(result) {
-				return
-			}
+	stream.Results = sdk.ListResultsConcurrently(ctx, request, metadata, results, func(ctx context.Context, account cognitiveservicesaccounts.Account, push func(list.ListResult) bool) {
+		result := request.NewListResult(ctx)
+		result.DisplayName = pointer.From(account.Name)
+
+		rd := resourceCognitiveAccount().Data(&terraform.InstanceState{})
+
+		id, err := cognitiveservicesaccounts.ParseAccountID(pointer.From(account.Id))
+		if err != nil {
+			sdk.SetErrorDiagnosticAndPushListResult(result, push, "parsing Cognitive Account ID", err)
+			return
 		}
-	}
+		rd.SetId(id.ID())
+
+		if err := resourceCognitiveAccountFlatten(ctx, client, rd, id, &account, request.IncludeResource); err != nil {
+			sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("encoding `%s` resource data", azureCognitiveAccountResourceName), err)
+			return
+		}
+
+		sdk.EncodeListResult(ctx, rd, &result)
+		push(result)
+	})
 }
@@ -107,6 +107,8 @@ func (r Registration) Resources() []sdk.Resource {
 func (r Registration) Actions() []func() action.Action {
 	return []func() action.Action{
 		newVirtualMachinePowerAction,
+		newVirtualMachineScaleSetInstancesAction,
+		newVirtualMachineScaleSetRollingUpgradeAction,
 	}
 }
 
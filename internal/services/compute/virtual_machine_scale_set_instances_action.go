@@ -0,0 +1,268 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2025-04-01/virtualmachinescalesets"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2025-04-01/virtualmachinescalesetvms"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type VirtualMachineScaleSetInstancesAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &VirtualMachineScaleSetInstancesAction{}
+
+func newVirtualMachineScaleSetInstancesAction() action.Action {
+	return &VirtualMachineScaleSetInstancesAction{}
+}
+
+type VirtualMachineScaleSetInstancesActionModel struct {
+	VirtualMachineScaleSetId types.String `tfsdk:"virtual_machine_scale_set_id"`
+	Action                   types.String `tfsdk:"instance_action"`
+	InstanceIds              types.List   `tfsdk:"instance_ids"`
+	ReimageOnUpgrade         types.Bool   `tfsdk:"reimage_on_upgrade"`
+	Timeout                  types.String `tfsdk:"timeout"`
+}
+
+func (v *VirtualMachineScaleSetInstancesAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"virtual_machine_scale_set_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the Virtual Machine Scale Set on which to perform the action.",
+				MarkdownDescription: "The ID of the Virtual Machine Scale Set on which to perform the action.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: virtualmachinescalesets.ValidateVirtualMachineScaleSetID,
+					},
+				},
+			},
+
+			"instance_action": schema.StringAttribute{
+				Required:            true,
+				Description:         "The action to take on the instances of this Virtual Machine Scale Set. Possible values are `reimage`, `redeploy` and `upgrade`.",
+				MarkdownDescription: "The action to take on the instances of this Virtual Machine Scale Set. Possible values are `reimage`, `redeploy` and `upgrade`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"reimage",
+						"redeploy",
+						"upgrade",
+					),
+				},
+			},
+
+			"instance_ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Description:         "A list of the instance IDs on which to perform the action, which are processed one at a time. When omitted `reimage` and `redeploy` are performed on all instances, and `upgrade` is performed on each instance which isn't running the latest model - which is only supported for Uniform Orchestration.",
+				MarkdownDescription: "A list of the instance IDs on which to perform the action, which are processed one at a time. When omitted `reimage` and `redeploy` are performed on all instances, and `upgrade` is performed on each instance which isn't running the latest model - which is only supported for Uniform Orchestration.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+
+			"reimage_on_upgrade": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Should each instance be reimaged after it has been upgraded to the latest model? Defaults to the value of the `reimage_on_manual_upgrade` feature.",
+				MarkdownDescription: "Should each instance be reimaged after it has been upgraded to the latest model? Defaults to the value of the `reimage_on_manual_upgrade` feature.",
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the action to complete. Defaults to `60m`.",
+				MarkdownDescription: "Timeout duration for the action to complete. Defaults to `60m`.",
+			},
+		},
+	}
+}
+
+func (v *VirtualMachineScaleSetInstancesAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_virtual_machine_scale_set_instances"
+}
+
+func (v *VirtualMachineScaleSetInstancesAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	model := VirtualMachineScaleSetInstancesActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctxTimeout := 60 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+
+		ctxTimeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	id, err := virtualmachinescalesets.ParseVirtualMachineScaleSetID(model.VirtualMachineScaleSetId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	instanceIds := make([]string, 0)
+	if !model.InstanceIds.IsNull() && !model.InstanceIds.IsUnknown() {
+		response.Diagnostics.Append(model.InstanceIds.ElementsAs(ctx, &instanceIds, false)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	reimageOnUpgrade := v.Client.Features.VirtualMachineScaleSet.ReimageOnManualUpgrade
+	if !model.ReimageOnUpgrade.IsNull() {
+		reimageOnUpgrade = model.ReimageOnUpgrade.ValueBool()
+	}
+
+	instanceAction := model.Action.ValueString()
+
+	if instanceAction == "upgrade" && len(instanceIds) == 0 {
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("determining the instances of %s which aren't running the latest model", id.VirtualMachineScaleSetName),
+		})
+
+		// the instances of a Flexible Scale Set aren't returned when listing the Scale Set VMs, and the Virtual Machines
+		// API doesn't expose whether the latest model has been applied - so the instances have to be specified
+		existing, err := v.Client.Compute.VirtualMachineScaleSetsClient.Get(ctx, *id, virtualmachinescalesets.DefaultGetOperationOptions())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("retrieving %s: %+v", id, err))
+			return
+		}
+		if model := existing.Model; model != nil && model.Properties != nil && pointer.From(model.Properties.OrchestrationMode) == virtualmachinescalesets.OrchestrationModeFlexible {
+			sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("`instance_ids` must be specified to `upgrade` the instances of %s, since determining the instances which aren't running the latest model is only supported for Uniform Orchestration", id))
+			return
+		}
+
+		vmssId := virtualmachinescalesetvms.NewVirtualMachineScaleSetID(id.SubscriptionId, id.ResourceGroupName, id.VirtualMachineScaleSetName)
+		instances, err := v.Client.Compute.VirtualMachineScaleSetVMsClient.ListComplete(ctx, vmssId, virtualmachinescalesetvms.DefaultListOperationOptions())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("listing the instances of %s, `instance_ids` must be specified for Scale Sets which do not support listing instances: %+v", id, err))
+			return
+		}
+
+		for _, item := range instances.Items {
+			if item.InstanceId == nil || item.Properties == nil {
+				continue
+			}
+
+			if !pointer.From(item.Properties.LatestModelApplied) {
+				instanceIds = append(instanceIds, *item.InstanceId)
+			}
+		}
+
+		if len(instanceIds) == 0 {
+			response.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("all instances of %s are running the latest model", id.VirtualMachineScaleSetName),
+			})
+			return
+		}
+	}
+
+	if len(instanceIds) == 0 {
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("invoking %s on all instances of %s", instanceAction, id.VirtualMachineScaleSetName),
+		})
+
+		if err := v.invokeOnInstances(ctx, *id, instanceAction, nil, reimageOnUpgrade); err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "running action", err)
+			return
+		}
+
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("action %s on all instances of %s completed", instanceAction, id.VirtualMachineScaleSetName),
+		})
+		return
+	}
+
+	// instances are processed one at a time, both to limit the number of unavailable instances and to allow progress
+	// to be reported for each instance
+	for i, instanceId := range instanceIds {
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("invoking %s on instance %q of %s (%d/%d)", instanceAction, instanceId, id.VirtualMachineScaleSetName, i+1, len(instanceIds)),
+		})
+
+		if err := v.invokeOnInstances(ctx, *id, instanceAction, []string{instanceId}, reimageOnUpgrade); err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "running action", err)
+			return
+		}
+
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("action %s on instance %q of %s completed (%d/%d)", instanceAction, instanceId, id.VirtualMachineScaleSetName, i+1, len(instanceIds)),
+		})
+	}
+}
+
+// invokeOnInstances performs the action on the specified instances, or all instances when `instanceIds` is nil
+func (v *VirtualMachineScaleSetInstancesAction) invokeOnInstances(ctx context.Context, id virtualmachinescalesets.VirtualMachineScaleSetId, instanceAction string, instanceIds []string, reimageOnUpgrade bool) error {
+	client := v.Client.Compute.VirtualMachineScaleSetsClient
+
+	var ids *[]string
+	if instanceIds != nil {
+		ids = pointer.To(instanceIds)
+	}
+
+	switch instanceAction {
+	case "reimage":
+		input := virtualmachinescalesets.VirtualMachineScaleSetReimageParameters{
+			InstanceIds: ids,
+		}
+		if err := client.ReimageThenPoll(ctx, id, input); err != nil {
+			return fmt.Errorf("reimaging instances %q of %s: %+v", instanceIds, id, err)
+		}
+
+	case "redeploy":
+		input := virtualmachinescalesets.VirtualMachineScaleSetVMInstanceIDs{
+			InstanceIds: ids,
+		}
+		if err := client.RedeployThenPoll(ctx, id, input); err != nil {
+			return fmt.Errorf("redeploying instances %q of %s: %+v", instanceIds, id, err)
+		}
+
+	case "upgrade":
+		input := virtualmachinescalesets.VirtualMachineScaleSetVMInstanceRequiredIDs{
+			InstanceIds: instanceIds,
+		}
+		if err := client.UpdateInstancesThenPoll(ctx, id, input); err != nil {
+			return fmt.Errorf("upgrading instances %q of %s to the latest model: %+v", instanceIds, id, err)
+		}
+
+		if reimageOnUpgrade {
+			reimageInput := virtualmachinescalesets.VirtualMachineScaleSetReimageParameters{
+				InstanceIds: ids,
+			}
+			if err := client.ReimageThenPoll(ctx, id, reimageInput); err != nil {
+				return fmt.Errorf("reimaging instances %q of %s: %+v", instanceIds, id, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (v *VirtualMachineScaleSetInstancesAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	v.Defaults(ctx, request, response)
+}
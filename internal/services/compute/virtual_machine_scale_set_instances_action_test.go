@@ -0,0 +1,124 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package compute_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type VirtualMachineScaleSetInstancesAction struct{}
+
+func TestAccVirtualMachineScaleSetInstancesAction_reimage(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_scale_set_instances", "test")
+	a := VirtualMachineScaleSetInstancesAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: LinuxVirtualMachineScaleSetResource{}.authPassword(data),
+			},
+			{
+				Config: a.allInstances(data, "reimage"),
+			},
+		},
+	})
+}
+
+func TestAccVirtualMachineScaleSetInstancesAction_redeployInstances(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_scale_set_instances", "test")
+	a := VirtualMachineScaleSetInstancesAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: LinuxVirtualMachineScaleSetResource{}.authPassword(data),
+			},
+			{
+				Config: a.instances(data, "redeploy"),
+			},
+		},
+	})
+}
+
+func TestAccVirtualMachineScaleSetInstancesAction_upgrade(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_scale_set_instances", "test")
+	a := VirtualMachineScaleSetInstancesAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: LinuxVirtualMachineScaleSetResource{}.authPassword(data),
+			},
+			{
+				Config: a.allInstances(data, "upgrade"),
+			},
+		},
+	})
+}
+
+func (VirtualMachineScaleSetInstancesAction) allInstances(data acceptance.TestData, instanceAction string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "terraform_data" "trigger" {
+  input = "%[2]s"
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_virtual_machine_scale_set_instances.test]
+    }
+  }
+}
+
+action "azurerm_virtual_machine_scale_set_instances" "test" {
+  config {
+    virtual_machine_scale_set_id = azurerm_linux_virtual_machine_scale_set.test.id
+    instance_action              = "%[2]s"
+  }
+}
+`, LinuxVirtualMachineScaleSetResource{}.authPassword(data), instanceAction)
+}
+
+func (VirtualMachineScaleSetInstancesAction) instances(data acceptance.TestData, instanceAction string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "terraform_data" "trigger" {
+  input = "%[2]s"
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_virtual_machine_scale_set_instances.test]
+    }
+  }
+}
+
+action "azurerm_virtual_machine_scale_set_instances" "test" {
+  config {
+    virtual_machine_scale_set_id = azurerm_linux_virtual_machine_scale_set.test.id
+    instance_action              = "%[2]s"
+    instance_ids                 = ["0"]
+  }
+}
+`, LinuxVirtualMachineScaleSetResource{}.authPassword(data), instanceAction)
+}
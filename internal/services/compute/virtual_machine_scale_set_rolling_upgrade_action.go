@@ -0,0 +1,215 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2025-04-01/virtualmachinescalesetrollingupgrades"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+// virtualMachineScaleSetRollingUpgradeProgressInterval is how often the progress of the instances is reported whilst
+// a Rolling Upgrade is running
+const virtualMachineScaleSetRollingUpgradeProgressInterval = 30 * time.Second
+
+type VirtualMachineScaleSetRollingUpgradeAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &VirtualMachineScaleSetRollingUpgradeAction{}
+
+func newVirtualMachineScaleSetRollingUpgradeAction() action.Action {
+	return &VirtualMachineScaleSetRollingUpgradeAction{}
+}
+
+type VirtualMachineScaleSetRollingUpgradeActionModel struct {
+	VirtualMachineScaleSetId types.String `tfsdk:"virtual_machine_scale_set_id"`
+	Action                   types.String `tfsdk:"rolling_upgrade_action"`
+	Timeout                  types.String `tfsdk:"timeout"`
+}
+
+func (v *VirtualMachineScaleSetRollingUpgradeAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"virtual_machine_scale_set_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the Virtual Machine Scale Set on which to perform the action.",
+				MarkdownDescription: "The ID of the Virtual Machine Scale Set on which to perform the action.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: virtualmachinescalesetrollingupgrades.ValidateVirtualMachineScaleSetID,
+					},
+				},
+			},
+
+			"rolling_upgrade_action": schema.StringAttribute{
+				Required:            true,
+				Description:         "The Rolling Upgrade action to take on this Virtual Machine Scale Set. Possible values are `start_os_upgrade`, `start_extension_upgrade` and `cancel`.",
+				MarkdownDescription: "The Rolling Upgrade action to take on this Virtual Machine Scale Set. Possible values are `start_os_upgrade`, `start_extension_upgrade` and `cancel`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"start_os_upgrade",
+						"start_extension_upgrade",
+						"cancel",
+					),
+				},
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the action to complete. Defaults to `60m`.",
+				MarkdownDescription: "Timeout duration for the action to complete. Defaults to `60m`.",
+			},
+		},
+	}
+}
+
+func (v *VirtualMachineScaleSetRollingUpgradeAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_virtual_machine_scale_set_rolling_upgrade"
+}
+
+func (v *VirtualMachineScaleSetRollingUpgradeAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := v.Client.Compute.VirtualMachineScaleSetRollingUpgradesClient
+
+	model := VirtualMachineScaleSetRollingUpgradeActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctxTimeout := 60 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+
+		ctxTimeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	id, err := virtualmachinescalesetrollingupgrades.ParseVirtualMachineScaleSetID(model.VirtualMachineScaleSetId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	rollingUpgradeAction := model.Action.ValueString()
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("invoking %s on %s", rollingUpgradeAction, id.VirtualMachineScaleSetName),
+	})
+
+	var poller pollers.Poller
+	switch rollingUpgradeAction {
+	case "start_os_upgrade":
+		resp, err := client.StartOSUpgrade(ctx, *id)
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("starting an OS Rolling Upgrade for %s: %+v", id, err))
+			return
+		}
+		poller = resp.Poller
+
+	case "start_extension_upgrade":
+		resp, err := client.StartExtensionUpgrade(ctx, *id)
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("starting an Extension Rolling Upgrade for %s: %+v", id, err))
+			return
+		}
+		poller = resp.Poller
+
+	case "cancel":
+		resp, err := client.Cancel(ctx, *id)
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("cancelling the Rolling Upgrade for %s: %+v", id, err))
+			return
+		}
+		poller = resp.Poller
+	}
+
+	// report the progress of the instances until the Rolling Upgrade completes, the reporter must have stopped before
+	// returning since progress can't be sent once the action has completed
+	done := make(chan struct{})
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		v.reportProgress(ctx, *id, done, response)
+	}()
+
+	err = poller.PollUntilDone(ctx)
+	close(done)
+	wg.Wait()
+
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("polling after %s for %s: %+v", rollingUpgradeAction, id, err))
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("action %s on %s completed", rollingUpgradeAction, id.VirtualMachineScaleSetName),
+	})
+}
+
+// reportProgress periodically retrieves the latest Rolling Upgrade and reports the number of instances in each state,
+// until `done` is closed or the context is cancelled
+func (v *VirtualMachineScaleSetRollingUpgradeAction) reportProgress(ctx context.Context, id virtualmachinescalesetrollingupgrades.VirtualMachineScaleSetId, done <-chan struct{}, response *action.InvokeResponse) {
+	client := v.Client.Compute.VirtualMachineScaleSetRollingUpgradesClient
+
+	ticker := time.NewTicker(virtualMachineScaleSetRollingUpgradeProgressInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		resp, err := client.GetLatest(ctx, id)
+		if err != nil || resp.Model == nil || resp.Model.Properties == nil || resp.Model.Properties.Progress == nil {
+			// progress is informational only, the result of the operation is determined by the poller
+			continue
+		}
+
+		status := "Unknown"
+		if runningStatus := resp.Model.Properties.RunningStatus; runningStatus != nil && runningStatus.Code != nil {
+			status = string(*runningStatus.Code)
+		}
+
+		progress := resp.Model.Properties.Progress
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("rolling upgrade of %s is %s: %d instance(s) succeeded, %d in progress, %d pending and %d failed",
+				id.VirtualMachineScaleSetName,
+				status,
+				pointer.From(progress.SuccessfulInstanceCount),
+				pointer.From(progress.InProgressInstanceCount),
+				pointer.From(progress.PendingInstanceCount),
+				pointer.From(progress.FailedInstanceCount),
+			),
+		})
+	}
+}
+
+func (v *VirtualMachineScaleSetRollingUpgradeAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	v.Defaults(ctx, request, response)
+}
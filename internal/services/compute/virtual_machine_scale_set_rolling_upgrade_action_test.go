@@ -0,0 +1,64 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package compute_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type VirtualMachineScaleSetRollingUpgradeAction struct{}
+
+func TestAccVirtualMachineScaleSetRollingUpgradeAction_startOSUpgrade(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_scale_set_rolling_upgrade", "test")
+	a := VirtualMachineScaleSetRollingUpgradeAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: a.template(data),
+			},
+			{
+				Config: a.basic(data, "start_os_upgrade"),
+			},
+		},
+	})
+}
+
+func (a VirtualMachineScaleSetRollingUpgradeAction) basic(data acceptance.TestData, rollingUpgradeAction string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "terraform_data" "trigger" {
+  input = "%[2]s"
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_virtual_machine_scale_set_rolling_upgrade.test]
+    }
+  }
+}
+
+action "azurerm_virtual_machine_scale_set_rolling_upgrade" "test" {
+  config {
+    virtual_machine_scale_set_id = azurerm_linux_virtual_machine_scale_set.test.id
+    rolling_upgrade_action       = "%[2]s"
+  }
+}
+`, a.template(data), rollingUpgradeAction)
+}
+
+func (VirtualMachineScaleSetRollingUpgradeAction) template(data acceptance.TestData) string {
+	return LinuxVirtualMachineScaleSetResource{}.otherRollingUpgradePolicyUpdate(data, false, 100, 100, 100, "PT0S", true, false)
+}
@@ -0,0 +1,272 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2025-11-01/registries"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ContainerRegistryImportImageAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &ContainerRegistryImportImageAction{}
+
+func newContainerRegistryImportImageAction() action.Action {
+	return &ContainerRegistryImportImageAction{}
+}
+
+type ContainerRegistryImportImageActionModel struct {
+	ContainerRegistryId        types.String `tfsdk:"container_registry_id"`
+	SourceImage                types.String `tfsdk:"source_image"`
+	SourceRegistryId           types.String `tfsdk:"source_registry_id"`
+	SourceRegistryUri          types.String `tfsdk:"source_registry_uri"`
+	SourceUsername             types.String `tfsdk:"source_username"`
+	SourcePassword             types.String `tfsdk:"source_password"`
+	TargetTags                 types.List   `tfsdk:"target_tags"`
+	UntaggedTargetRepositories types.List   `tfsdk:"untagged_target_repositories"`
+	Force                      types.Bool   `tfsdk:"force"`
+	Timeout                    types.String `tfsdk:"timeout"`
+}
+
+func (c *ContainerRegistryImportImageAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"container_registry_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the Container Registry into which the image should be imported.",
+				MarkdownDescription: "The ID of the Container Registry into which the image should be imported.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: registries.ValidateRegistryID,
+					},
+				},
+			},
+
+			"source_image": schema.StringAttribute{
+				Required:            true,
+				Description:         "The repository and tag or digest of the image to import, for example `library/nginx:latest` or `library/nginx@sha256:...`.",
+				MarkdownDescription: "The repository and tag or digest of the image to import, for example `library/nginx:latest` or `library/nginx@sha256:...`.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.StringIsNotEmpty,
+					},
+				},
+			},
+
+			"source_registry_id": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The ID of the Container Registry from which the image should be imported.",
+				MarkdownDescription: "The ID of the Container Registry from which the image should be imported.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: registries.ValidateRegistryID,
+					},
+					stringvalidator.ExactlyOneOf(
+						path.MatchRoot("source_registry_id"),
+						path.MatchRoot("source_registry_uri"),
+					),
+				},
+			},
+
+			"source_registry_uri": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The address of the registry from which the image should be imported, for example `docker.io` or `mcr.microsoft.com`.",
+				MarkdownDescription: "The address of the registry from which the image should be imported, for example `docker.io` or `mcr.microsoft.com`.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.StringIsNotEmpty,
+					},
+				},
+			},
+
+			"source_username": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The username used to authenticate with the source registry.",
+				MarkdownDescription: "The username used to authenticate with the source registry.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.StringIsNotEmpty,
+					},
+					stringvalidator.AlsoRequires(path.MatchRoot("source_password")),
+				},
+			},
+
+			"source_password": schema.StringAttribute{
+				Optional:            true,
+				WriteOnly:           true,
+				Description:         "The password or token used to authenticate with the source registry.",
+				MarkdownDescription: "The password or token used to authenticate with the source registry.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.StringIsNotEmpty,
+					},
+				},
+			},
+
+			"target_tags": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Description:         "A list of repositories and tags the image should be imported as, for example `base/nginx:stable`. Defaults to the repository and tag of the `source_image` when neither `target_tags` nor `untagged_target_repositories` are specified.",
+				MarkdownDescription: "A list of repositories and tags the image should be imported as, for example `base/nginx:stable`. Defaults to the repository and tag of the `source_image` when neither `target_tags` nor `untagged_target_repositories` are specified.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+
+			"untagged_target_repositories": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Description:         "A list of repositories the image should be imported into without a tag, where the manifest is only referenced by its digest.",
+				MarkdownDescription: "A list of repositories the image should be imported into without a tag, where the manifest is only referenced by its digest.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+
+			"force": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Should any existing tags in the target Container Registry be overwritten? Defaults to `false`, in which case the import fails when a target tag already exists.",
+				MarkdownDescription: "Should any existing tags in the target Container Registry be overwritten? Defaults to `false`, in which case the import fails when a target tag already exists.",
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the action to complete. Defaults to `30m`.",
+				MarkdownDescription: "Timeout duration for the action to complete. Defaults to `30m`.",
+			},
+		},
+	}
+}
+
+func (c *ContainerRegistryImportImageAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_container_registry_import_image"
+}
+
+func (c *ContainerRegistryImportImageAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := c.Client.Containers.ContainerRegistryClient.Registries
+
+	model := ContainerRegistryImportImageActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctxTimeout := 30 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+
+		ctxTimeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	id, err := registries.ParseRegistryID(model.ContainerRegistryId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	sourceImage := model.SourceImage.ValueString()
+	input := registries.ImportImageParameters{
+		Mode: pointer.To(registries.ImportModeNoForce),
+		Source: registries.ImportSource{
+			SourceImage: sourceImage,
+		},
+	}
+
+	source := ""
+	if v := model.SourceRegistryId.ValueString(); v != "" {
+		sourceId, err := registries.ParseRegistryID(v)
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `source_registry_id`", err)
+			return
+		}
+		input.Source.ResourceId = pointer.To(sourceId.ID())
+		source = sourceId.RegistryName
+	}
+
+	if v := model.SourceRegistryUri.ValueString(); v != "" {
+		input.Source.RegistryUri = pointer.To(v)
+		source = v
+	}
+
+	if v := model.SourcePassword.ValueString(); v != "" {
+		input.Source.Credentials = &registries.ImportSourceCredentials{
+			Password: v,
+			Username: pointer.To(model.SourceUsername.ValueString()),
+		}
+	}
+
+	if !model.TargetTags.IsNull() && !model.TargetTags.IsUnknown() {
+		targetTags := make([]string, 0)
+		response.Diagnostics.Append(model.TargetTags.ElementsAs(ctx, &targetTags, false)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		input.TargetTags = pointer.To(targetTags)
+	}
+
+	if !model.UntaggedTargetRepositories.IsNull() && !model.UntaggedTargetRepositories.IsUnknown() {
+		repositories := make([]string, 0)
+		response.Diagnostics.Append(model.UntaggedTargetRepositories.ElementsAs(ctx, &repositories, false)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		input.UntaggedTargetRepositories = pointer.To(repositories)
+	}
+
+	if model.Force.ValueBool() {
+		input.Mode = pointer.To(registries.ImportModeForce)
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("importing %q from %q into %s", sourceImage, source, id.RegistryName),
+	})
+
+	accepted := func() error {
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("import of %q accepted by %s, waiting for the import to complete", sourceImage, id.RegistryName),
+		})
+		return nil
+	}
+
+	if err := client.ImportImageCallbackThenPoll(ctx, *id, input, accepted); err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("importing %q from %q into %s: %+v", sourceImage, source, id, err))
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("import of %q into %s completed", sourceImage, id.RegistryName),
+	})
+}
+
+func (c *ContainerRegistryImportImageAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	c.Defaults(ctx, request, response)
+}
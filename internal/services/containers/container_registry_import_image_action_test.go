@@ -0,0 +1,115 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package containers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type ContainerRegistryImportImageAction struct{}
+
+func TestAccContainerRegistryImportImageAction_publicRegistry(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_import_image", "test")
+	a := ContainerRegistryImportImageAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: ContainerRegistryResource{}.basicManaged(data, "Basic"),
+			},
+			{
+				Config: a.publicRegistry(data),
+			},
+		},
+	})
+}
+
+func TestAccContainerRegistryImportImageAction_containerRegistry(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_import_image", "test")
+	a := ContainerRegistryImportImageAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: a.publicRegistry(data),
+			},
+			{
+				Config: a.containerRegistry(data),
+			},
+		},
+	})
+}
+
+func (ContainerRegistryImportImageAction) publicRegistry(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "terraform_data" "trigger" {
+  input = azurerm_container_registry.test.id
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_container_registry_import_image.test]
+    }
+  }
+}
+
+action "azurerm_container_registry_import_image" "test" {
+  config {
+    container_registry_id = azurerm_container_registry.test.id
+    source_registry_uri   = "mcr.microsoft.com"
+    source_image          = "azuredocs/aci-helloworld:latest"
+    target_tags           = ["samples/helloworld:latest", "samples/helloworld:v1"]
+    force                 = true
+  }
+}
+`, ContainerRegistryResource{}.basicManaged(data, "Basic"))
+}
+
+func (a ContainerRegistryImportImageAction) containerRegistry(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry" "second" {
+  name                = "testacccr2%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "Basic"
+}
+
+resource "terraform_data" "second" {
+  input = azurerm_container_registry.second.id
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_container_registry_import_image.second]
+    }
+  }
+}
+
+action "azurerm_container_registry_import_image" "second" {
+  config {
+    container_registry_id        = azurerm_container_registry.second.id
+    source_registry_id           = azurerm_container_registry.test.id
+    source_image                 = "samples/helloworld:v1"
+    untagged_target_repositories = ["samples/helloworld"]
+  }
+}
+`, a.publicRegistry(data), data.RandomInteger)
+}
@@ -30,13 +30,6 @@ func (KubernetesAutomaticClusterListResource) Metadata(_ context.Context, _ reso
 
 func (KubernetesAutomaticClusterListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, metadata sdk.ResourceMetadata) {
 	// retrieve the deadline from the supplied context
-	deadline, ok := ctx.Deadline()
-	if !ok {
-		// This *should* never happen given the List Wrapper instantiates a context with a timeout
-		sdk.SetResponseErrorDiagnostic(stream, "internal-error", "context had no deadline")
-		return
-	}
-
 	client := metadata.Client.Containers.KubernetesClustersClient_v2026_04_01
 	var data sdk.DefaultListModel
 	diags := request.Config.Get(ctx, &data)
@@ -72,37 +65,25 @@ func (KubernetesAutomaticClusterListResource) List(ctx context.Context, request
 		results = resp.Items
 	}
 
-	stream.Results = func(push func(list.ListResult) bool) {
-		ctx, cancel := context.WithDeadline(context.Background(), deadline)
-		defer cancel()
-
-		for _, item := range results {
-			result := request.NewListResult(ctx)
-			result.DisplayName = pointer.From(item.Name)
-
-			id, err := commonids.ParseKubernetesClusterIDInsensitively(pointer.From(item.Id))
-			if err != nil {
-				sdk.SetErrorDiagnosticAndPushListResult(result, push, "parsing Kubernetes Cluster ID", err)
-				return
-			}
-
-			rmd := sdk.NewResourceMetaData(metadata.Client, r)
-			rmd.SetID(id)
-
-			if err := r.flatten(ctx, rmd, id, &item, false); err != nil {
-				sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("encoding `%s` resource data", r.ResourceType()), err)
-				return
-			}
-
-			sdk.EncodeListResult(ctx, rmd.ResourceData, &result)
-			if result.Diagnostics.HasError() {
-				push(result)
-				return
-			}
-
-			if !push(result) {
-				return
-			}
+	stream.Results = sdk.ListResultsConcurrently(ctx, request, metadata, results, func(ctx context.Context, item managedclusters.ManagedCluster, push func(list.ListResult) bool) {
+		result := request.NewListResult(ctx)
+		result.DisplayName = pointer.From(item.Name)
+
+		id, err := commonids.ParseKubernetesClusterIDInsensitively(pointer.From(item.Id))
+		if err != nil {
+			sdk.SetErrorDiagnosticAndPushListResult(result, push, "parsing Kubernetes Cluster ID", err)
+			return
+		}
+
+		rmd := sdk.NewResourceMetaData(metadata.Client, r)
+		rmd.SetID(id)
+
+		if err := r.flatten(ctx, rmd, id, &item, false); err != nil {
+			sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("encoding `%s` resource data", r.ResourceType()), err)
+			return
 		}
-	}
+
+		sdk.EncodeListResult(ctx, rmd.ResourceData, &result)
+		push(result)
+	})
 }
@@ -0,0 +1,143 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type KubernetesClusterCredentialRotationAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &KubernetesClusterCredentialRotationAction{}
+
+func newKubernetesClusterCredentialRotationAction() action.Action {
+	return &KubernetesClusterCredentialRotationAction{}
+}
+
+type KubernetesClusterCredentialRotationActionModel struct {
+	KubernetesClusterId types.String `tfsdk:"kubernetes_cluster_id"`
+	CredentialType      types.String `tfsdk:"credential_type"`
+	Timeout             types.String `tfsdk:"timeout"`
+}
+
+func (k *KubernetesClusterCredentialRotationAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"kubernetes_cluster_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the Kubernetes Cluster whose credentials should be rotated.",
+				MarkdownDescription: "The ID of the Kubernetes Cluster whose credentials should be rotated.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: commonids.ValidateKubernetesClusterID,
+					},
+				},
+			},
+
+			"credential_type": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The type of credential to rotate. Possible values are `cluster_certificates` and `service_account_signing_keys`. Defaults to `cluster_certificates`.",
+				MarkdownDescription: "The type of credential to rotate. Possible values are `cluster_certificates` and `service_account_signing_keys`. Defaults to `cluster_certificates`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"cluster_certificates",
+						"service_account_signing_keys",
+					),
+				},
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the action to complete. Defaults to `90m`.",
+				MarkdownDescription: "Timeout duration for the action to complete. Defaults to `90m`.",
+			},
+		},
+	}
+}
+
+func (k *KubernetesClusterCredentialRotationAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_kubernetes_cluster_credential_rotation"
+}
+
+func (k *KubernetesClusterCredentialRotationAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := k.Client.Containers.KubernetesClustersClient
+
+	model := KubernetesClusterCredentialRotationActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctxTimeout := 90 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+
+		ctxTimeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	id, err := commonids.ParseKubernetesClusterID(model.KubernetesClusterId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	credentialType := "cluster_certificates"
+	if v := model.CredentialType.ValueString(); v != "" {
+		credentialType = v
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("rotating %s on %s", credentialType, id.ManagedClusterName),
+	})
+
+	accepted := func() error {
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("rotation of %s accepted for %s, waiting for the operation to complete", credentialType, id.ManagedClusterName),
+		})
+		return nil
+	}
+
+	switch credentialType {
+	case "cluster_certificates":
+		if err := client.RotateClusterCertificatesCallbackThenPoll(ctx, *id, accepted); err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("rotating the cluster certificates of %s: %+v", id, err))
+			return
+		}
+
+	case "service_account_signing_keys":
+		if err := client.RotateServiceAccountSigningKeysCallbackThenPoll(ctx, *id, accepted); err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("rotating the service account signing keys of %s: %+v", id, err))
+			return
+		}
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("rotation of %s on %s completed", credentialType, id.ManagedClusterName),
+	})
+}
+
+func (k *KubernetesClusterCredentialRotationAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	k.Defaults(ctx, request, response)
+}
@@ -0,0 +1,60 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package containers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type KubernetesClusterCredentialRotationAction struct{}
+
+func TestAccKubernetesClusterCredentialRotationAction_clusterCertificates(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_credential_rotation", "test")
+	a := KubernetesClusterCredentialRotationAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: KubernetesClusterResource{}.basic(data),
+			},
+			{
+				Config: a.basic(data, "cluster_certificates"),
+			},
+		},
+	})
+}
+
+func (KubernetesClusterCredentialRotationAction) basic(data acceptance.TestData, credentialType string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "terraform_data" "trigger" {
+  input = "%[2]s"
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_kubernetes_cluster_credential_rotation.test]
+    }
+  }
+}
+
+action "azurerm_kubernetes_cluster_credential_rotation" "test" {
+  config {
+    kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+    credential_type       = "%[2]s"
+  }
+}
+`, KubernetesClusterResource{}.basic(data), credentialType)
+}
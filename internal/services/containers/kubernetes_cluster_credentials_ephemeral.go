@@ -0,0 +1,185 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2026-04-01/managedclusters"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+const (
+	kubernetesClusterCredentialTypeAdmin          = "Admin"
+	kubernetesClusterCredentialTypeMonitoringUser = "MonitoringUser"
+	kubernetesClusterCredentialTypeUser           = "User"
+)
+
+var _ sdk.EphemeralResource = &KubernetesClusterCredentialsEphemeralResource{}
+
+func NewKubernetesClusterCredentialsEphemeralResource() ephemeral.EphemeralResource {
+	return &KubernetesClusterCredentialsEphemeralResource{}
+}
+
+type KubernetesClusterCredentialsEphemeralResource struct {
+	sdk.EphemeralResourceMetadata
+}
+
+type KubernetesClusterCredentialsEphemeralResourceModel struct {
+	KubernetesClusterID types.String                                                          `tfsdk:"kubernetes_cluster_id"`
+	CredentialType      types.String                                                          `tfsdk:"credential_type"`
+	KubeConfigRaw       types.String                                                          `tfsdk:"kube_config_raw"`
+	KubeConfig          typehelpers.ListNestedObjectValueOf[KubernetesClusterKubeConfigModel] `tfsdk:"kube_config"`
+}
+
+type KubernetesClusterKubeConfigModel struct {
+	Host                 types.String `tfsdk:"host"`
+	Username             types.String `tfsdk:"username"`
+	Password             types.String `tfsdk:"password"`
+	ClientCertificate    types.String `tfsdk:"client_certificate"`
+	ClientKey            types.String `tfsdk:"client_key"`
+	ClusterCACertificate types.String `tfsdk:"cluster_ca_certificate"`
+}
+
+func (e *KubernetesClusterCredentialsEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "azurerm_kubernetes_cluster_credentials"
+}
+
+func (e *KubernetesClusterCredentialsEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	e.Defaults(req, resp)
+}
+
+func (e *KubernetesClusterCredentialsEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"kubernetes_cluster_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: commonids.ValidateKubernetesClusterID,
+					},
+				},
+			},
+
+			"credential_type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The type of credentials which should be retrieved. Possible values are `Admin`, `MonitoringUser` and `User`. Defaults to `User`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						kubernetesClusterCredentialTypeAdmin,
+						kubernetesClusterCredentialTypeMonitoringUser,
+						kubernetesClusterCredentialTypeUser,
+					),
+				},
+			},
+
+			"kube_config_raw": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			// NOTE: nested attributes aren't supported by protocol version 5, so this is exposed as a list of objects
+			"kube_config": schema.ListAttribute{
+				Computed:   true,
+				Sensitive:  true,
+				CustomType: typehelpers.NewListNestedObjectTypeOf[KubernetesClusterKubeConfigModel](ctx),
+			},
+		},
+	}
+}
+
+func (e *KubernetesClusterCredentialsEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	client := e.Client.Containers.KubernetesClustersClient_v2026_04_01
+	ctx, cancel := context.WithTimeout(ctx, time.Minute*5)
+	defer cancel()
+
+	var data KubernetesClusterCredentialsEphemeralResourceModel
+
+	if ok := e.DecodeOpen(ctx, req, resp, &data); !ok {
+		return
+	}
+
+	id, err := commonids.ParseKubernetesClusterID(data.KubernetesClusterID.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, "", err)
+		return
+	}
+
+	credentialType := kubernetesClusterCredentialTypeUser
+	if v := data.CredentialType.ValueString(); v != "" {
+		credentialType = v
+	}
+
+	var credentials *managedclusters.CredentialResults
+	var configName string
+	switch credentialType {
+	case kubernetesClusterCredentialTypeAdmin:
+		result, err := client.ListClusterAdminCredentials(ctx, *id, managedclusters.DefaultListClusterAdminCredentialsOperationOptions())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("retrieving Admin Credentials for %s", id), err)
+			return
+		}
+		credentials = result.Model
+		configName = "clusterAdmin"
+
+	case kubernetesClusterCredentialTypeMonitoringUser:
+		result, err := client.ListClusterMonitoringUserCredentials(ctx, *id, managedclusters.DefaultListClusterMonitoringUserCredentialsOperationOptions())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("retrieving Monitoring User Credentials for %s", id), err)
+			return
+		}
+		credentials = result.Model
+		configName = "clusterMonitoringUser"
+
+	default:
+		result, err := client.ListClusterUserCredentials(ctx, *id, managedclusters.DefaultListClusterUserCredentialsOperationOptions())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("retrieving User Credentials for %s", id), err)
+			return
+		}
+		credentials = result.Model
+		configName = "clusterUser"
+	}
+
+	kubeConfigRaw, kubeConfig := flattenKubernetesClusterCredentialsTyped(credentials, configName)
+	if kubeConfigRaw == nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("retrieving %s Credentials for %s", credentialType, id), fmt.Errorf("no kube config named %q was returned", configName))
+		return
+	}
+
+	kubeConfigs := make([]KubernetesClusterKubeConfigModel, 0, len(kubeConfig))
+	for _, v := range kubeConfig {
+		kubeConfigs = append(kubeConfigs, KubernetesClusterKubeConfigModel{
+			Host:                 types.StringValue(v.Host),
+			Username:             types.StringValue(v.Username),
+			Password:             types.StringValue(v.Password),
+			ClientCertificate:    types.StringValue(v.ClientCertificate),
+			ClientKey:            types.StringValue(v.ClientKey),
+			ClusterCACertificate: types.StringValue(v.ClusterCACertificate),
+		})
+	}
+
+	kubeConfigValue, diags := typehelpers.NewListNestedObjectValueOfValueSlice(ctx, kubeConfigs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.CredentialType = types.StringValue(credentialType)
+	data.KubeConfigRaw = types.StringValue(pointer.From(kubeConfigRaw))
+	data.KubeConfig = kubeConfigValue
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
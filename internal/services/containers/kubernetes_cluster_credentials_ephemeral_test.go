@@ -0,0 +1,105 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package containers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type KubernetesClusterCredentialsEphemeral struct{}
+
+func TestAccEphemeralKubernetesClusterCredentials_user(t *testing.T) {
+	data := acceptance.BuildTestData(t, "ephemeral.azurerm_kubernetes_cluster_credentials", "test")
+	r := KubernetesClusterCredentialsEphemeral{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.10.0-rc1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		ProtoV6ProviderFactories: framework.ProtoV6ProviderFactoriesInit(context.Background(), "azurerm", "echo"),
+		Steps: []resource.TestStep{
+			{
+				Config: r.basic(data, "User"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("kube_config_raw"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("kube_config"), knownvalue.ListSizeExact(1)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccEphemeralKubernetesClusterCredentials_admin(t *testing.T) {
+	data := acceptance.BuildTestData(t, "ephemeral.azurerm_kubernetes_cluster_credentials", "test")
+	r := KubernetesClusterCredentialsEphemeral{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.10.0-rc1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		ProtoV6ProviderFactories: framework.ProtoV6ProviderFactoriesInit(context.Background(), "azurerm", "echo"),
+		Steps: []resource.TestStep{
+			{
+				Config: r.basic(data, "Admin"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("credential_type"), knownvalue.StringExact("Admin")),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("kube_config_raw"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("kube_config"), knownvalue.ListSizeExact(1)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccEphemeralKubernetesClusterCredentials_monitoringUser(t *testing.T) {
+	data := acceptance.BuildTestData(t, "ephemeral.azurerm_kubernetes_cluster_credentials", "test")
+	r := KubernetesClusterCredentialsEphemeral{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.10.0-rc1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		ProtoV6ProviderFactories: framework.ProtoV6ProviderFactoriesInit(context.Background(), "azurerm", "echo"),
+		Steps: []resource.TestStep{
+			{
+				Config: r.basic(data, "MonitoringUser"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("credential_type"), knownvalue.StringExact("MonitoringUser")),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("kube_config_raw"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+func (KubernetesClusterCredentialsEphemeral) basic(data acceptance.TestData, credentialType string) string {
+	return fmt.Sprintf(`
+%s
+
+ephemeral "azurerm_kubernetes_cluster_credentials" "test" {
+  kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+  credential_type       = "%s"
+}
+
+provider "echo" {
+  data = ephemeral.azurerm_kubernetes_cluster_credentials.test
+}
+
+resource "echo" "test" {}
+`, KubernetesClusterResource{}.basic(data), credentialType)
+}
@@ -0,0 +1,115 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2025-10-01/agentpools"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type KubernetesClusterNodePoolNodeImageUpgradeAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &KubernetesClusterNodePoolNodeImageUpgradeAction{}
+
+func newKubernetesClusterNodePoolNodeImageUpgradeAction() action.Action {
+	return &KubernetesClusterNodePoolNodeImageUpgradeAction{}
+}
+
+type KubernetesClusterNodePoolNodeImageUpgradeActionModel struct {
+	NodePoolId types.String `tfsdk:"node_pool_id"`
+	Timeout    types.String `tfsdk:"timeout"`
+}
+
+func (k *KubernetesClusterNodePoolNodeImageUpgradeAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"node_pool_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the Kubernetes Cluster Node Pool whose Node Image should be upgraded to the latest version.",
+				MarkdownDescription: "The ID of the Kubernetes Cluster Node Pool whose Node Image should be upgraded to the latest version.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: agentpools.ValidateAgentPoolID,
+					},
+				},
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the action to complete. Defaults to `90m`.",
+				MarkdownDescription: "Timeout duration for the action to complete. Defaults to `90m`.",
+			},
+		},
+	}
+}
+
+func (k *KubernetesClusterNodePoolNodeImageUpgradeAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_kubernetes_cluster_node_pool_node_image_upgrade"
+}
+
+func (k *KubernetesClusterNodePoolNodeImageUpgradeAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := k.Client.Containers.AgentPoolsClient
+
+	model := KubernetesClusterNodePoolNodeImageUpgradeActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctxTimeout := 90 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+
+		ctxTimeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	id, err := agentpools.ParseAgentPoolID(model.NodePoolId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("upgrading the node image of %s in %s", id.AgentPoolName, id.ManagedClusterName),
+	})
+
+	accepted := func() error {
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("node image upgrade accepted for %s in %s, waiting for the nodes to be upgraded", id.AgentPoolName, id.ManagedClusterName),
+		})
+		return nil
+	}
+
+	if err := client.UpgradeNodeImageVersionCallbackThenPoll(ctx, *id, accepted); err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("upgrading the node image of %s: %+v", id, err))
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("node image upgrade of %s in %s completed", id.AgentPoolName, id.ManagedClusterName),
+	})
+}
+
+func (k *KubernetesClusterNodePoolNodeImageUpgradeAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	k.Defaults(ctx, request, response)
+}
@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package containers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type KubernetesClusterNodePoolNodeImageUpgradeAction struct{}
+
+func TestAccKubernetesClusterNodePoolNodeImageUpgradeAction_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_node_pool_node_image_upgrade", "test")
+	a := KubernetesClusterNodePoolNodeImageUpgradeAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: KubernetesClusterResource{}.basic(data),
+			},
+			{
+				Config: a.basic(data),
+			},
+		},
+	})
+}
+
+func (KubernetesClusterNodePoolNodeImageUpgradeAction) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "terraform_data" "trigger" {
+  input = azurerm_kubernetes_cluster.test.default_node_pool[0].name
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_kubernetes_cluster_node_pool_node_image_upgrade.test]
+    }
+  }
+}
+
+action "azurerm_kubernetes_cluster_node_pool_node_image_upgrade" "test" {
+  config {
+    node_pool_id = "${azurerm_kubernetes_cluster.test.id}/agentPools/${azurerm_kubernetes_cluster.test.default_node_pool[0].name}"
+  }
+}
+`, KubernetesClusterResource{}.basic(data))
+}
@@ -0,0 +1,63 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package containers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type KubernetesClusterPowerAction struct{}
+
+func TestAccKubernetesClusterPowerAction_stopAndStart(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_power", "test")
+	a := KubernetesClusterPowerAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: KubernetesClusterResource{}.basic(data),
+			},
+			{
+				Config: a.powerAction(data, "stop"),
+			},
+			{
+				Config: a.powerAction(data, "start"),
+			},
+		},
+	})
+}
+
+func (KubernetesClusterPowerAction) powerAction(data acceptance.TestData, powerAction string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "terraform_data" "trigger" {
+  input = "%[2]s"
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.azurerm_kubernetes_cluster_power.test]
+    }
+  }
+}
+
+action "azurerm_kubernetes_cluster_power" "test" {
+  config {
+    kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+    power_action          = "%[2]s"
+  }
+}
+`, KubernetesClusterResource{}.basic(data), powerAction)
+}
@@ -93,7 +93,12 @@ func (r Registration) Resources() []sdk.Resource {
 }
 
 func (r Registration) Actions() []func() action.Action {
-	return []func() action.Action{}
+	return []func() action.Action{
+		newContainerRegistryImportImageAction,
+		newKubernetesClusterCredentialRotationAction,
+		newKubernetesClusterNodePoolNodeImageUpgradeAction,
+		newKubernetesClusterPowerAction,
+	}
 }
 
 func (r Registration) FrameworkResources() []sdk.FrameworkWrappedResource {
@@ -105,7 +110,9 @@ func (r Registration) FrameworkDataSources() []sdk.FrameworkWrappedDataSource {
 }
 
 func (r Registration) EphemeralResources() []func() ephemeral.EphemeralResource {
-	return []func() ephemeral.EphemeralResource{}
+	return []func() ephemeral.EphemeralResource{
+		NewKubernetesClusterCredentialsEphemeralResource,
+	}
 }
 
 func (r Registration) ListResources() []sdk.FrameworkListWrappedResource {
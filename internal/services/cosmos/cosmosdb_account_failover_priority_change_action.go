@@ -0,0 +1,175 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package cosmos
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2024-08-15/cosmosdb"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type CosmosDBAccountFailoverPriorityChangeAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &CosmosDBAccountFailoverPriorityChangeAction{}
+
+func newCosmosDBAccountFailoverPriorityChangeAction() action.Action {
+	return &CosmosDBAccountFailoverPriorityChangeAction{}
+}
+
+type CosmosDBAccountFailoverPriorityChangeActionModel struct {
+	CosmosDBAccountId types.String `tfsdk:"cosmosdb_account_id"`
+	Locations         types.List   `tfsdk:"locations"`
+	Timeout           types.String `tfsdk:"timeout"`
+}
+
+func (c *CosmosDBAccountFailoverPriorityChangeAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"cosmosdb_account_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the CosmosDB Account whose failover priorities should be changed.",
+				MarkdownDescription: "The ID of the CosmosDB Account whose failover priorities should be changed.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: cosmosdb.ValidateDatabaseAccountID,
+					},
+				},
+			},
+
+			"locations": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Required:            true,
+				Description:         "The Azure Regions of the CosmosDB Account in the desired order of failover priority. The first Region becomes the write Region. All Regions of the CosmosDB Account must be specified.",
+				MarkdownDescription: "The Azure Regions of the CosmosDB Account in the desired order of failover priority. The first Region becomes the write Region. All Regions of the CosmosDB Account must be specified.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the action to complete. Defaults to `60m`.",
+				MarkdownDescription: "Timeout duration for the action to complete. Defaults to `60m`.",
+			},
+		},
+	}
+}
+
+func (c *CosmosDBAccountFailoverPriorityChangeAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_cosmosdb_account_failover_priority_change"
+}
+
+func (c *CosmosDBAccountFailoverPriorityChangeAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := c.Client.Cosmos.CosmosDBClient
+
+	model := CosmosDBAccountFailoverPriorityChangeActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctxTimeout := 60 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+
+		ctxTimeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	id, err := cosmosdb.ParseDatabaseAccountID(model.CosmosDBAccountId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	locations := make([]string, 0)
+	response.Diagnostics.Append(model.Locations.ElementsAs(ctx, &locations, false)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	existing, err := client.DatabaseAccountsGet(ctx, *id)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("retrieving %s: %+v", id, err))
+		return
+	}
+	if existing.Model == nil || existing.Model.Properties == nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("retrieving %s: `properties` was nil", id))
+		return
+	}
+
+	// the API requires every Region of the account to be given a priority, so this is validated up front to
+	// return a clearer error than the one returned by the API
+	existingLocations := make(map[string]struct{})
+	for _, l := range pointer.From(existing.Model.Properties.Locations) {
+		existingLocations[location.NormalizeNilable(l.LocationName)] = struct{}{}
+	}
+
+	policies := make([]cosmosdb.FailoverPolicy, 0)
+	for i, l := range locations {
+		normalized := location.Normalize(l)
+		if _, ok := existingLocations[normalized]; !ok {
+			sdk.SetResponseErrorDiagnostic(response, "validating `locations`", fmt.Sprintf("the Region %q is not a Region of %s", l, id))
+			return
+		}
+		delete(existingLocations, normalized)
+
+		policies = append(policies, cosmosdb.FailoverPolicy{
+			LocationName:     pointer.To(normalized),
+			FailoverPriority: pointer.To(int64(i)),
+		})
+	}
+
+	if len(existingLocations) > 0 {
+		missing := make([]string, 0)
+		for l := range existingLocations {
+			missing = append(missing, l)
+		}
+		sort.Strings(missing)
+		sdk.SetResponseErrorDiagnostic(response, "validating `locations`", fmt.Sprintf("all Regions of %s must be specified, missing: %s", id, strings.Join(missing, ", ")))
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("changing the failover priorities of %s to %s", id, strings.Join(locations, ", ")),
+	})
+
+	if err := client.DatabaseAccountsFailoverPriorityChangeThenPoll(ctx, *id, cosmosdb.FailoverPolicies{FailoverPolicies: policies}); err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("changing the failover priorities of %s: %+v", id, err))
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("changed the failover priorities of %s, the write Region is now %q", id, location.Normalize(locations[0])),
+	})
+}
+
+func (c *CosmosDBAccountFailoverPriorityChangeAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	c.Defaults(ctx, request, response)
+}
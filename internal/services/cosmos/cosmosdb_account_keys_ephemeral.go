@@ -0,0 +1,149 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package cosmos
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2024-08-15/cosmosdb"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+var _ sdk.EphemeralResource = &CosmosDbAccountKeysEphemeralResource{}
+
+func NewCosmosDbAccountKeysEphemeralResource() ephemeral.EphemeralResource {
+	return &CosmosDbAccountKeysEphemeralResource{}
+}
+
+type CosmosDbAccountKeysEphemeralResource struct {
+	sdk.EphemeralResourceMetadata
+}
+
+type CosmosDbAccountKeysEphemeralResourceModel struct {
+	CosmosDbAccountId                        types.String `tfsdk:"cosmosdb_account_id"`
+	PrimaryKey                               types.String `tfsdk:"primary_key"`
+	SecondaryKey                             types.String `tfsdk:"secondary_key"`
+	PrimaryReadonlyKey                       types.String `tfsdk:"primary_readonly_key"`
+	SecondaryReadonlyKey                     types.String `tfsdk:"secondary_readonly_key"`
+	PrimarySqlConnectionString               types.String `tfsdk:"primary_sql_connection_string"`
+	SecondarySqlConnectionString             types.String `tfsdk:"secondary_sql_connection_string"`
+	PrimaryReadonlySqlConnectionString       types.String `tfsdk:"primary_readonly_sql_connection_string"`
+	SecondaryReadonlySqlConnectionString     types.String `tfsdk:"secondary_readonly_sql_connection_string"`
+	PrimaryMongoDBConnectionString           types.String `tfsdk:"primary_mongodb_connection_string"`
+	SecondaryMongoDBConnectionString         types.String `tfsdk:"secondary_mongodb_connection_string"`
+	PrimaryReadonlyMongoDBConnectionString   types.String `tfsdk:"primary_readonly_mongodb_connection_string"`
+	SecondaryReadonlyMongoDBConnectionString types.String `tfsdk:"secondary_readonly_mongodb_connection_string"`
+}
+
+func (e *CosmosDbAccountKeysEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "azurerm_cosmosdb_account_keys"
+}
+
+func (e *CosmosDbAccountKeysEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	e.Defaults(req, resp)
+}
+
+func (e *CosmosDbAccountKeysEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"cosmosdb_account_id": schema.StringAttribute{
+			Required: true,
+			Validators: []validator.String{
+				typehelpers.WrappedStringValidator{
+					Func: cosmosdb.ValidateDatabaseAccountID,
+				},
+			},
+		},
+	}
+
+	for _, name := range []string{"primary_key", "secondary_key", "primary_readonly_key", "secondary_readonly_key"} {
+		attributes[name] = schema.StringAttribute{
+			Computed:  true,
+			Sensitive: true,
+		}
+	}
+
+	for _, name := range connStringPropertyMap {
+		attributes[name] = schema.StringAttribute{
+			Computed:  true,
+			Sensitive: true,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: attributes,
+	}
+}
+
+func (e *CosmosDbAccountKeysEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	client := e.Client.Cosmos.CosmosDBClient
+	ctx, cancel := context.WithTimeout(ctx, time.Minute*5)
+	defer cancel()
+
+	var data CosmosDbAccountKeysEphemeralResourceModel
+
+	if ok := e.DecodeOpen(ctx, req, resp, &data); !ok {
+		return
+	}
+
+	id, err := cosmosdb.ParseDatabaseAccountID(data.CosmosDbAccountId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, "", err)
+		return
+	}
+
+	keys, err := client.DatabaseAccountsListKeys(ctx, *id)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("listing keys for %s", id), err)
+		return
+	}
+
+	if model := keys.Model; model != nil {
+		data.PrimaryKey = types.StringValue(pointer.From(model.PrimaryMasterKey))
+		data.SecondaryKey = types.StringValue(pointer.From(model.SecondaryMasterKey))
+		data.PrimaryReadonlyKey = types.StringValue(pointer.From(model.PrimaryReadonlyMasterKey))
+		data.SecondaryReadonlyKey = types.StringValue(pointer.From(model.SecondaryReadonlyMasterKey))
+	}
+
+	connStrings, err := client.DatabaseAccountsListConnectionStrings(ctx, *id)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("listing connection strings for %s", id), err)
+		return
+	}
+
+	// the connection strings which are returned depend on the `kind` and capabilities of the account, as such any which
+	// are not returned are set to an empty string
+	connStringValues := map[string]*types.String{
+		"primary_sql_connection_string":                &data.PrimarySqlConnectionString,
+		"secondary_sql_connection_string":              &data.SecondarySqlConnectionString,
+		"primary_readonly_sql_connection_string":       &data.PrimaryReadonlySqlConnectionString,
+		"secondary_readonly_sql_connection_string":     &data.SecondaryReadonlySqlConnectionString,
+		"primary_mongodb_connection_string":            &data.PrimaryMongoDBConnectionString,
+		"secondary_mongodb_connection_string":          &data.SecondaryMongoDBConnectionString,
+		"primary_readonly_mongodb_connection_string":   &data.PrimaryReadonlyMongoDBConnectionString,
+		"secondary_readonly_mongodb_connection_string": &data.SecondaryReadonlyMongoDBConnectionString,
+	}
+	for _, v := range connStringValues {
+		*v = types.StringValue("")
+	}
+
+	if model := connStrings.Model; model != nil && model.ConnectionStrings != nil {
+		for _, v := range *model.ConnectionStrings {
+			if propertyName, propertyExists := connStringPropertyMap[pointer.From(v.Description)]; propertyExists {
+				if value, ok := connStringValues[propertyName]; ok {
+					*value = types.StringValue(pointer.From(v.ConnectionString))
+				}
+			}
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
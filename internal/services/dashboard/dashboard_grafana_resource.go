@@ -17,7 +17,6 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dashboard/2025-08-01/managedgrafanas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/preflight"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -63,8 +62,9 @@ type SMTPConfigurationModel struct {
 type DashboardGrafanaResource struct{}
 
 var (
-	_ sdk.ResourceWithUpdate   = DashboardGrafanaResource{}
-	_ sdk.ResourceWithIdentity = DashboardGrafanaResource{}
+	_ sdk.ResourceWithUpdate    = DashboardGrafanaResource{}
+	_ sdk.ResourceWithIdentity  = DashboardGrafanaResource{}
+	_ sdk.ResourceWithPreflight = DashboardGrafanaResource{}
 )
 
 func (r DashboardGrafanaResource) Identity() resourceids.ResourceId {
@@ -567,38 +567,22 @@ func (r DashboardGrafanaResource) Delete() sdk.ResourceFunc {
 	}
 }
 
-func (r DashboardGrafanaResource) CustomizeDiff() sdk.ResourceFunc {
-	return sdk.ResourceFunc{
-		Timeout: 5 * time.Minute,
-		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
-			rd := metadata.ResourceDiff
-
-			if metadata.Client.Features.EnhancedValidation.PreflightEnabled {
-				// Only perform preflight validation if there are changes. This avoids validation failures and
-				// additional API calls for resources that are unchanged between plan invocations
-				if len(rd.GetChangedKeysPrefix("")) > 0 || rd.Id() == "" {
-					var model DashboardGrafanaModel
-					if err := metadata.DecodeDiff(&model); err != nil {
-						return err
-					}
-
-					req := expandCreateForDashboardGrafana(model)
-
-					id := managedgrafanas.NewGrafanaID(metadata.Client.Account.SubscriptionId, model.ResourceGroupName, model.Name)
-
-					preflightValidate, err := preflight.NewValidationRequest(pointer.To(model.Location), pointer.To(id), "2025-08-01", req.Properties)
-					if err != nil {
-						return fmt.Errorf("constructing preflight validation request: %w", err)
-					}
+func (r DashboardGrafanaResource) PreflightPayload() sdk.PreflightPayloadFunc {
+	return func(ctx context.Context, metadata sdk.ResourceMetaData) (*sdk.PreflightPayload, error) {
+		var model DashboardGrafanaModel
+		if err := metadata.DecodeDiff(&model); err != nil {
+			return nil, err
+		}
 
-					if err = preflightValidate.ValidateResource(ctx, metadata); err != nil {
-						return err
-					}
-				}
-			}
+		req := expandCreateForDashboardGrafana(model)
 
-			return nil
-		},
+		id := managedgrafanas.NewGrafanaID(metadata.Client.Account.SubscriptionId, model.ResourceGroupName, model.Name)
+		return &sdk.PreflightPayload{
+			Location:   pointer.To(model.Location),
+			ResourceId: pointer.To(id),
+			ApiVersion: "2025-08-01",
+			Properties: req.Properties,
+		}, nil
 	}
 }
 
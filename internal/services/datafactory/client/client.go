@@ -6,6 +6,7 @@ package client
 import (
 	"fmt"
 
+	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/activityruns"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/credentials"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/dataflows"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/factories"
@@ -15,12 +16,14 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/linkedservices"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/managedprivateendpoints"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/managedvirtualnetworks"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/pipelineruns"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/pipelines"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/jackofallops/kermit/sdk/datafactory/2018-06-01/datafactory" // nolint: staticcheck
 )
 
 type Client struct {
+	ActivityRunsClient                              *activityruns.ActivityrunsClient
 	Factories                                       *factories.FactoriesClient
 	Credentials                                     *credentials.CredentialsClient
 	DataFlowClient                                  *dataflows.DataFlowsClient
@@ -30,6 +33,7 @@ type Client struct {
 	LinkedServicesClient                            *linkedservices.LinkedServicesClient
 	ManagedPrivateEndpoints                         *managedprivateendpoints.ManagedPrivateEndpointsClient
 	ManagedVirtualNetworks                          *managedvirtualnetworks.ManagedVirtualNetworksClient
+	PipelineRunsClient                              *pipelineruns.PipelineRunsClient
 	PipelinesClient                                 *pipelines.PipelinesClient
 
 	// TODO: convert to using hashicorp/go-azure-sdk
@@ -39,6 +43,12 @@ type Client struct {
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
+	activityRunsClient, err := activityruns.NewActivityrunsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Activity Runs client: %+v", err)
+	}
+	o.Configure(activityRunsClient.Client, o.Authorizers.ResourceManager)
+
 	credentialsClient, err := credentials.NewCredentialsClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building Credentials client: %+v", err)
@@ -93,6 +103,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(managedVirtualNetworksClient.Client, o.Authorizers.ResourceManager)
 
+	pipelineRunsClient, err := pipelineruns.NewPipelineRunsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Pipeline Runs client: %+v", err)
+	}
+	o.Configure(pipelineRunsClient.Client, o.Authorizers.ResourceManager)
+
 	// TODO: port the below operations to use `hashicorp/go-azure-sdk` in time
 	DatasetClient := datafactory.NewDatasetsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&DatasetClient.Client, o.ResourceManagerAuthorizer)
@@ -110,15 +126,17 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	o.ConfigureClient(&TriggersClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		Factories:      factoriesClient,
-		Credentials:    credentialsClient,
-		DataFlowClient: dataFlowClient,
+		ActivityRunsClient: activityRunsClient,
+		Factories:          factoriesClient,
+		Credentials:        credentialsClient,
+		DataFlowClient:     dataFlowClient,
 		IntegrationRuntimeDisableInteractiveQueryClient: integrationRuntimeDisableInteractiveQueryClient,
 		IntegrationRuntimeEnableInteractiveQueryClient:  integrationRuntimeEnableInteractiveQueryClient,
 		IntegrationRuntimesClient:                       integrationRuntimesClient,
 		LinkedServicesClient:                            linkedServicesClient,
 		ManagedPrivateEndpoints:                         managedPrivateEndpointsClient,
 		ManagedVirtualNetworks:                          managedVirtualNetworksClient,
+		PipelineRunsClient:                              pipelineRunsClient,
 		PipelinesClient:                                 PipelinesClient,
 
 		// TODO: port to `hashicorp/go-azure-sdk`
@@ -0,0 +1,259 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package datafactory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/activityruns"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/pipelineruns"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/pipelines"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type DataFactoryPipelineRunAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &DataFactoryPipelineRunAction{}
+
+func newDataFactoryPipelineRunAction() action.Action {
+	return &DataFactoryPipelineRunAction{}
+}
+
+type DataFactoryPipelineRunActionModel struct {
+	PipelineId        types.String `tfsdk:"pipeline_id"`
+	Parameters        types.Map    `tfsdk:"parameters"`
+	WaitForCompletion types.Bool   `tfsdk:"wait_for_completion"`
+	Timeout           types.String `tfsdk:"timeout"`
+}
+
+func (d *DataFactoryPipelineRunAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"pipeline_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the Data Factory Pipeline to run.",
+				MarkdownDescription: "The ID of the Data Factory Pipeline to run.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: pipelines.ValidatePipelineID,
+					},
+				},
+			},
+
+			"parameters": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Description:         "A map of parameters to pass to the Pipeline run.",
+				MarkdownDescription: "A map of parameters to pass to the Pipeline run.",
+			},
+
+			"wait_for_completion": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Should the action wait for the Pipeline run to complete, and fail if the run does not succeed? Defaults to `true`.",
+				MarkdownDescription: "Should the action wait for the Pipeline run to complete, and fail if the run does not succeed? Defaults to `true`.",
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the action to complete. Defaults to `60m`.",
+				MarkdownDescription: "Timeout duration for the action to complete. Defaults to `60m`.",
+			},
+		},
+	}
+}
+
+func (d *DataFactoryPipelineRunAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_data_factory_pipeline_run"
+}
+
+func (d *DataFactoryPipelineRunAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := d.Client.DataFactory.PipelinesClient
+	runsClient := d.Client.DataFactory.PipelineRunsClient
+
+	model := DataFactoryPipelineRunActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctxTimeout := 60 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+
+		ctxTimeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	id, err := pipelines.ParsePipelineID(model.PipelineId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	parameters := make(map[string]string)
+	if !model.Parameters.IsNull() && !model.Parameters.IsUnknown() {
+		response.Diagnostics.Append(model.Parameters.ElementsAs(ctx, &parameters, false)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	input := make(map[string]interface{}, len(parameters))
+	for k, v := range parameters {
+		input[k] = v
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("starting a run of %s", id),
+	})
+
+	resp, err := client.CreateRun(ctx, *id, input, pipelines.DefaultCreateRunOperationOptions())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("starting a run of %s: %+v", id, err))
+		return
+	}
+	if resp.Model == nil || resp.Model.RunId == "" {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("starting a run of %s: the run ID was nil", id))
+		return
+	}
+	runId := pipelineruns.NewPipelineRunID(id.SubscriptionId, id.ResourceGroupName, id.FactoryName, resp.Model.RunId)
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("started run %q of %s", runId.RunId, id),
+	})
+
+	if !model.WaitForCompletion.IsNull() && !model.WaitForCompletion.ValueBool() {
+		return
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		sdk.SetResponseErrorDiagnostic(response, "running action", "internal-error: context had no deadline")
+		return
+	}
+
+	lastStatus := ""
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{"Queued", "InProgress", "Canceling"},
+		Target:  []string{"Succeeded", "Failed", "Cancelled"},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := runsClient.Get(ctx, runId)
+			if err != nil {
+				return nil, "", fmt.Errorf("retrieving run %q of %s: %+v", runId.RunId, id, err)
+			}
+			if resp.Model == nil {
+				return nil, "", fmt.Errorf("retrieving run %q of %s: `model` was nil", runId.RunId, id)
+			}
+			run := *resp.Model
+
+			status := pointer.From(run.Status)
+			if status != lastStatus {
+				response.SendProgress(action.InvokeProgressEvent{
+					Message: fmt.Sprintf("run %q of %s is %s", runId.RunId, id, status),
+				})
+				lastStatus = status
+			}
+
+			return run, status, nil
+		},
+		MinTimeout:   15 * time.Second,
+		PollInterval: 15 * time.Second,
+		Timeout:      time.Until(deadline),
+	}
+
+	result, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("waiting for run %q of %s to complete: %+v", runId.RunId, id, err))
+		return
+	}
+
+	run := result.(pipelineruns.PipelineRun)
+	if status := pointer.From(run.Status); status != "Succeeded" {
+		response.Diagnostics.AddError("running action", fmt.Sprintf("run %q of %s completed with the status %q: %s", runId.RunId, id, status, pointer.From(run.Message)))
+
+		activityErrors, err := d.failedActivityErrors(ctx, *id, runId, run)
+		if err != nil {
+			response.Diagnostics.AddWarning("retrieving activity errors", err.Error())
+			return
+		}
+
+		for _, v := range activityErrors {
+			response.Diagnostics.AddError(fmt.Sprintf("activity failed in run %q", runId.RunId), v)
+		}
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("run %q of %s completed successfully", runId.RunId, id),
+	})
+}
+
+// failedActivityErrors returns the errors of each of the failed Activities within the Pipeline run
+func (d *DataFactoryPipelineRunAction) failedActivityErrors(ctx context.Context, id pipelines.PipelineId, runId pipelineruns.PipelineRunId, run pipelineruns.PipelineRun) ([]string, error) {
+	client := d.Client.DataFactory.ActivityRunsClient
+	activityRunsId := activityruns.NewPipelineRunID(runId.SubscriptionId, runId.ResourceGroupName, runId.FactoryName, runId.RunId)
+
+	// the Activity runs must be queried within a time window, which is the duration of the Pipeline run
+	updatedAfter := time.Now().Add(-24 * time.Hour)
+	if runStart, err := run.GetRunStartAsTime(); err == nil && runStart != nil {
+		updatedAfter = runStart.Add(-time.Minute)
+	}
+
+	filter := activityruns.RunFilterParameters{
+		Filters: &[]activityruns.RunQueryFilter{
+			{
+				Operand:  activityruns.RunQueryFilterOperandStatus,
+				Operator: activityruns.RunQueryFilterOperatorEquals,
+				Values:   []string{"Failed"},
+			},
+		},
+	}
+	filter.SetLastUpdatedAfterAsTime(updatedAfter)
+	filter.SetLastUpdatedBeforeAsTime(time.Now().Add(time.Minute))
+
+	activityErrors := make([]string, 0)
+	for {
+		resp, err := client.QueryByPipelineRun(ctx, activityRunsId, filter)
+		if err != nil {
+			return nil, fmt.Errorf("querying the activity runs of run %q of %s: %+v", runId.RunId, id, err)
+		}
+		if resp.Model == nil {
+			break
+		}
+
+		for _, activity := range resp.Model.Value {
+			activityErrors = append(activityErrors, fmt.Sprintf("%s (%s): %s", pointer.From(activity.ActivityName), pointer.From(activity.ActivityType), azure.FlattenActivityRunError(activity.Error)))
+		}
+
+		if resp.Model.ContinuationToken == nil || *resp.Model.ContinuationToken == "" {
+			break
+		}
+		filter.ContinuationToken = resp.Model.ContinuationToken
+	}
+
+	return activityErrors, nil
+}
+
+func (d *DataFactoryPipelineRunAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	d.Defaults(ctx, request, response)
+}
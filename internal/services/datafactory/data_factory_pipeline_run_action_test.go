@@ -0,0 +1,143 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package datafactory_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type DataFactoryPipelineRunAction struct{}
+
+func TestAccDataFactoryPipelineRunAction_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_pipeline_run", "test")
+	a := DataFactoryPipelineRunAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: a.basic(data),
+			},
+		},
+	})
+}
+
+func TestAccDataFactoryPipelineRunAction_failure(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_pipeline_run", "test")
+	a := DataFactoryPipelineRunAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      a.failure(data),
+				ExpectError: regexp.MustCompile("acctest-failure"),
+			},
+		},
+	})
+}
+
+func (DataFactoryPipelineRunAction) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_data_factory_pipeline" "test" {
+  name            = "acctest%[2]d"
+  data_factory_id = azurerm_data_factory.test.id
+  parameters = {
+    "wait_seconds" = "1"
+  }
+  activities_json = <<JSON
+[
+  {
+    "name": "Wait",
+    "type": "Wait",
+    "dependsOn": [],
+    "userProperties": [],
+    "typeProperties": {
+      "waitTimeInSeconds": {
+        "value": "@int(pipeline().parameters.wait_seconds)",
+        "type": "Expression"
+      }
+    }
+  }
+]
+JSON
+}
+
+resource "terraform_data" "trigger" {
+  input = azurerm_data_factory_pipeline.test.id
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_data_factory_pipeline_run.test]
+    }
+  }
+}
+
+action "azurerm_data_factory_pipeline_run" "test" {
+  config {
+    pipeline_id = azurerm_data_factory_pipeline.test.id
+    parameters = {
+      "wait_seconds" = "5"
+    }
+  }
+}
+`, PipelineResource{}.template(data), data.RandomInteger)
+}
+
+func (DataFactoryPipelineRunAction) failure(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_data_factory_pipeline" "test" {
+  name            = "acctest%[2]d"
+  data_factory_id = azurerm_data_factory.test.id
+  activities_json = <<JSON
+[
+  {
+    "name": "Fail",
+    "type": "Fail",
+    "dependsOn": [],
+    "userProperties": [],
+    "typeProperties": {
+      "message": "acctest-failure",
+      "errorCode": "500"
+    }
+  }
+]
+JSON
+}
+
+resource "terraform_data" "trigger" {
+  input = azurerm_data_factory_pipeline.test.id
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_data_factory_pipeline_run.test]
+    }
+  }
+}
+
+action "azurerm_data_factory_pipeline_run" "test" {
+  config {
+    pipeline_id = azurerm_data_factory_pipeline.test.id
+  }
+}
+`, PipelineResource{}.template(data), data.RandomInteger)
+}
@@ -4,6 +4,7 @@
 package dns
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"time"
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/recordsets"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -21,6 +23,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name dns_a_record -properties "name,resource_group_name,dns_zone_name:zone_name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "record_type:id"
+
 func resourceDnsARecord() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceDnsARecordCreateUpdate,
@@ -34,16 +38,11 @@ func resourceDnsARecord() *pluginsdk.Resource {
 			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			parsed, err := recordsets.ParseRecordTypeID(id)
-			if err != nil {
-				return err
-			}
-			if parsed.RecordType != recordsets.RecordTypeA {
-				return fmt.Errorf("this resource only supports 'A' records")
-			}
-			return nil
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentityThen(&recordsets.RecordTypeId{}, resourceDnsARecordImporter),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&recordsets.RecordTypeId{}),
+		},
 
 		SchemaVersion: 1,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
@@ -97,6 +96,17 @@ func resourceDnsARecord() *pluginsdk.Resource {
 	}
 }
 
+func resourceDnsARecordImporter(_ context.Context, d *pluginsdk.ResourceData, _ interface{}) ([]*pluginsdk.ResourceData, error) {
+	id, err := recordsets.ParseRecordTypeID(d.Id())
+	if err != nil {
+		return []*pluginsdk.ResourceData{d}, err
+	}
+	if id.RecordType != recordsets.RecordTypeA {
+		return []*pluginsdk.ResourceData{d}, fmt.Errorf("this resource only supports 'A' records")
+	}
+	return []*pluginsdk.ResourceData{d}, nil
+}
+
 func resourceDnsARecordCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Dns.RecordSets
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
@@ -152,6 +162,9 @@ func resourceDnsARecordCreateUpdate(d *pluginsdk.ResourceData, meta interface{})
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 
 	return resourceDnsARecordRead(d, meta)
 }
@@ -175,11 +188,15 @@ func resourceDnsARecordRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
+	return resourceDnsARecordFlatten(d, id, resp.Model)
+}
+
+func resourceDnsARecordFlatten(d *pluginsdk.ResourceData, id *recordsets.RecordTypeId, model *recordsets.RecordSet) error {
 	d.Set("name", id.RelativeRecordSetName)
 	d.Set("resource_group_name", id.ResourceGroupName)
 	d.Set("zone_name", id.DnsZoneName)
 
-	if model := resp.Model; model != nil {
+	if model != nil {
 		if props := model.Properties; props != nil {
 			d.Set("fqdn", props.Fqdn)
 			d.Set("ttl", props.TTL)
@@ -200,7 +217,7 @@ func resourceDnsARecordRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceDnsARecordDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -0,0 +1,39 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package dns
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/recordsets"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type DnsARecordListResource struct{}
+
+var _ sdk.FrameworkListWrappedResourceWithConfig = new(DnsARecordListResource)
+
+func (r DnsARecordListResource) ResourceFunc() *pluginsdk.Resource {
+	return resourceDnsARecord()
+}
+
+func (r DnsARecordListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "azurerm_dns_a_record"
+}
+
+func (r DnsARecordListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = dnsRecordListResourceConfigSchema()
+}
+
+func (r DnsARecordListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, metadata sdk.ResourceMetadata) {
+	dnsRecordListResource{
+		resourceType: "azurerm_dns_a_record",
+		recordType:   recordsets.RecordTypeA,
+		resourceFunc: resourceDnsARecord,
+		flattenFunc:  resourceDnsARecordFlatten,
+	}.List(ctx, request, stream, metadata)
+}
@@ -5,6 +5,7 @@ package dns
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"strconv"
 	"time"
@@ -14,6 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/recordsets"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/migration"
@@ -21,6 +23,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name dns_mx_record -properties "name,resource_group_name,dns_zone_name:zone_name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "record_type:id"
+
 func resourceDnsMxRecord() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceDnsMxRecordCreateUpdate,
@@ -35,16 +39,11 @@ func resourceDnsMxRecord() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			parsed, err := recordsets.ParseRecordTypeID(id)
-			if err != nil {
-				return err
-			}
-			if parsed.RecordType != recordsets.RecordTypeMX {
-				return fmt.Errorf("this resource only supports 'MX' records")
-			}
-			return nil
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentityThen(&recordsets.RecordTypeId{}, resourceDnsMxRecordImporter),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&recordsets.RecordTypeId{}),
+		},
 
 		SchemaVersion: 1,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
@@ -102,6 +101,17 @@ func resourceDnsMxRecord() *pluginsdk.Resource {
 	}
 }
 
+func resourceDnsMxRecordImporter(_ context.Context, d *pluginsdk.ResourceData, _ interface{}) ([]*pluginsdk.ResourceData, error) {
+	id, err := recordsets.ParseRecordTypeID(d.Id())
+	if err != nil {
+		return []*pluginsdk.ResourceData{d}, err
+	}
+	if id.RecordType != recordsets.RecordTypeMX {
+		return []*pluginsdk.ResourceData{d}, fmt.Errorf("this resource only supports 'MX' records")
+	}
+	return []*pluginsdk.ResourceData{d}, nil
+}
+
 func resourceDnsMxRecordCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Dns.RecordSets
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
@@ -145,6 +155,9 @@ func resourceDnsMxRecordCreateUpdate(d *pluginsdk.ResourceData, meta interface{}
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 	return resourceDnsMxRecordRead(d, meta)
 }
 
@@ -167,11 +180,15 @@ func resourceDnsMxRecordRead(d *pluginsdk.ResourceData, meta interface{}) error
 		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
+	return resourceDnsMxRecordFlatten(d, id, resp.Model)
+}
+
+func resourceDnsMxRecordFlatten(d *pluginsdk.ResourceData, id *recordsets.RecordTypeId, model *recordsets.RecordSet) error {
 	d.Set("name", id.RelativeRecordSetName)
 	d.Set("resource_group_name", id.ResourceGroupName)
 	d.Set("zone_name", id.DnsZoneName)
 
-	if model := resp.Model; model != nil {
+	if model != nil {
 		if props := model.Properties; props != nil {
 			d.Set("ttl", props.TTL)
 			d.Set("fqdn", props.Fqdn)
@@ -185,7 +202,7 @@ func resourceDnsMxRecordRead(d *pluginsdk.ResourceData, meta interface{}) error
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceDnsMxRecordDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -0,0 +1,157 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package dns
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourcegroups"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/recordsets"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/zones"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// DnsRecordListModel is the List Resource configuration shared by each of the DNS Record types
+type DnsRecordListModel struct {
+	DnsZoneId         types.String `tfsdk:"dns_zone_id"`
+	ResourceGroupName types.String `tfsdk:"resource_group_name"`
+	SubscriptionId    types.String `tfsdk:"subscription_id"`
+}
+
+// dnsRecordListResource contains the details which differ between the List Resources for each DNS Record type
+type dnsRecordListResource struct {
+	resourceType string
+	recordType   recordsets.RecordType
+	resourceFunc func() *pluginsdk.Resource
+	flattenFunc  func(d *pluginsdk.ResourceData, id *recordsets.RecordTypeId, model *recordsets.RecordSet) error
+}
+
+func dnsRecordListResourceConfigSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"dns_zone_id": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: zones.ValidateDnsZoneID,
+					},
+					stringvalidator.ConflictsWith(path.MatchRoot("resource_group_name"), path.MatchRoot("subscription_id")),
+				},
+			},
+			"resource_group_name": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: resourcegroups.ValidateName,
+					},
+				},
+			},
+			"subscription_id": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.IsUUID,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r dnsRecordListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, metadata sdk.ResourceMetadata) {
+	client := metadata.Client.Dns.RecordSets
+
+	var data DnsRecordListModel
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	zoneIds := make([]zones.DnsZoneId, 0)
+	if !data.DnsZoneId.IsNull() {
+		zoneId, err := zones.ParseDnsZoneID(data.DnsZoneId.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("parsing DNS Zone ID for `%s`", r.resourceType), err)
+			return
+		}
+		zoneIds = append(zoneIds, *zoneId)
+	} else {
+		subscriptionID := metadata.SubscriptionId
+		if !data.SubscriptionId.IsNull() {
+			subscriptionID = data.SubscriptionId.ValueString()
+		}
+
+		// the Record Sets can only be listed per DNS Zone, so the DNS Zones in scope are listed first
+		dnsZones, err := listDnsZones(ctx, metadata.Client.Dns.Zones, subscriptionID, data.ResourceGroupName.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing DNS Zones for `%s`", r.resourceType), err)
+			return
+		}
+
+		for _, zone := range dnsZones {
+			zoneId, err := zones.ParseDnsZoneIDInsensitively(pointer.From(zone.Id))
+			if err != nil {
+				sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("parsing DNS Zone ID for `%s`", r.resourceType), err)
+				return
+			}
+			zoneIds = append(zoneIds, *zoneId)
+		}
+	}
+
+	results := make([]recordsets.RecordSet, 0)
+	for _, zoneId := range zoneIds {
+		id := recordsets.NewZoneID(zoneId.SubscriptionId, zoneId.ResourceGroupName, zoneId.DnsZoneName, r.recordType)
+		resp, err := client.ListByTypeComplete(ctx, id, recordsets.DefaultListByTypeOperationOptions())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", r.resourceType), err)
+			return
+		}
+
+		results = append(results, resp.Items...)
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, item := range results {
+			result := request.NewListResult(ctx)
+			result.DisplayName = pointer.From(item.Name)
+
+			rd := r.resourceFunc().Data(&terraform.InstanceState{})
+
+			id, err := recordsets.ParseRecordTypeIDInsensitively(pointer.From(item.Id))
+			if err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("parsing DNS %s Record ID", r.recordType), err)
+				return
+			}
+
+			rd.SetId(id.ID())
+
+			if err := r.flattenFunc(rd, id, &item); err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("encoding `%s` resource data", r.resourceType), err)
+				return
+			}
+
+			sdk.EncodeListResult(ctx, rd, &result)
+			if result.Diagnostics.HasError() {
+				push(result)
+				return
+			}
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
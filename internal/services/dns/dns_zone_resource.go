@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/recordsets"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/zones"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -23,13 +24,20 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name dns_zone -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 var (
 	_ sdk.ResourceWithUpdate         = DnsZoneResource{}
+	_ sdk.ResourceWithIdentity       = DnsZoneResource{}
 	_ sdk.ResourceWithStateMigration = DnsZoneResource{}
 )
 
 type DnsZoneResource struct{}
 
+func (DnsZoneResource) Identity() resourceids.ResourceId {
+	return &zones.DnsZoneId{}
+}
+
 func (DnsZoneResource) ModelObject() interface{} {
 	return &DnsZoneResourceModel{}
 }
@@ -217,6 +225,9 @@ func (r DnsZoneResource) Create() sdk.ResourceFunc {
 				return fmt.Errorf("creating %s: %+v", id, err)
 			}
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			if len(model.SoaRecord) == 1 {
 				soaRecordID := recordsets.NewRecordTypeID(id.SubscriptionId, id.ResourceGroupName, id.DnsZoneName, recordsets.RecordTypeSOA, "@")
@@ -257,14 +268,11 @@ func (r DnsZoneResource) Create() sdk.ResourceFunc {
 	}
 }
 
-func (DnsZoneResource) Read() sdk.ResourceFunc {
+func (r DnsZoneResource) Read() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 5 * time.Minute,
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
 			zonesClient := metadata.Client.Dns.Zones
-			recordSetsClient := metadata.Client.Dns.RecordSets
-
-			state := DnsZoneResourceModel{}
 
 			id, err := zones.ParseDnsZoneID(metadata.ResourceData.Id())
 			if err != nil {
@@ -279,29 +287,41 @@ func (DnsZoneResource) Read() sdk.ResourceFunc {
 				return fmt.Errorf("retrieving %s: %+v", *id, err)
 			}
 
-			soaRecord := recordsets.NewRecordTypeID(id.SubscriptionId, id.ResourceGroupName, id.DnsZoneName, recordsets.RecordTypeSOA, "@")
-			soaRecordResp, err := recordSetsClient.Get(ctx, soaRecord)
-			if err != nil {
-				return fmt.Errorf("retrieving %s: %+v", id, err)
-			}
+			return r.flatten(ctx, metadata, id, resp.Model)
+		},
+	}
+}
 
-			state.SoaRecord = flattenDNSZoneSOARecord(soaRecordResp.Model)
+func (r DnsZoneResource) flatten(ctx context.Context, metadata sdk.ResourceMetaData, id *zones.DnsZoneId, model *zones.Zone) error {
+	recordSetsClient := metadata.Client.Dns.RecordSets
 
-			state.Name = id.DnsZoneName
-			state.ResourceGroupName = id.ResourceGroupName
+	state := DnsZoneResourceModel{
+		Name:              id.DnsZoneName,
+		ResourceGroupName: id.ResourceGroupName,
+	}
 
-			if model := resp.Model; model != nil {
-				if props := model.Properties; props != nil {
-					state.NumberOfRecordSets = pointer.From(props.NumberOfRecordSets)
-					state.MaxNumberOfRecordSets = pointer.From(props.MaxNumberOfRecordSets)
-					state.NameServers = pointer.From(props.NameServers)
-				}
-				state.Tags = pointer.From(model.Tags)
-			}
+	soaRecord := recordsets.NewRecordTypeID(id.SubscriptionId, id.ResourceGroupName, id.DnsZoneName, recordsets.RecordTypeSOA, "@")
+	soaRecordResp, err := recordSetsClient.Get(ctx, soaRecord)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
 
-			return metadata.Encode(&state)
-		},
+	state.SoaRecord = flattenDNSZoneSOARecord(soaRecordResp.Model)
+
+	if model != nil {
+		if props := model.Properties; props != nil {
+			state.NumberOfRecordSets = pointer.From(props.NumberOfRecordSets)
+			state.MaxNumberOfRecordSets = pointer.From(props.MaxNumberOfRecordSets)
+			state.NameServers = pointer.From(props.NameServers)
+		}
+		state.Tags = pointer.From(model.Tags)
 	}
+
+	if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+		return err
+	}
+
+	return metadata.Encode(&state)
 }
 
 func (r DnsZoneResource) Update() sdk.ResourceFunc {
@@ -0,0 +1,101 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package dns
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/zones"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type DnsZoneListResource struct{}
+
+var _ sdk.FrameworkListWrappedResourceWithResourceGraph = new(DnsZoneListResource)
+
+func (DnsZoneListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = DnsZoneResource{}.ResourceType()
+}
+
+func (DnsZoneListResource) ResourceFunc() *pluginsdk.Resource {
+	return sdk.WrappedResource(DnsZoneResource{})
+}
+
+func (DnsZoneListResource) ResourceGraphType() string {
+	return "microsoft.network/dnszones"
+}
+
+func (DnsZoneListResource) ResourceId() resourceids.ResourceId {
+	return &zones.DnsZoneId{}
+}
+
+func (DnsZoneListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, metadata sdk.ResourceMetadata) {
+	var data sdk.DefaultListModel
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	r := DnsZoneResource{}
+
+	subscriptionID := metadata.SubscriptionId
+	if !data.SubscriptionId.IsNull() {
+		subscriptionID = data.SubscriptionId.ValueString()
+	}
+
+	results, err := listDnsZones(ctx, metadata.Client.Dns.Zones, subscriptionID, data.ResourceGroupName.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", r.ResourceType()), err)
+		return
+	}
+
+	stream.Results = sdk.ListResultsConcurrently(ctx, request, metadata, results, func(ctx context.Context, item zones.Zone, push func(list.ListResult) bool) {
+		result := request.NewListResult(ctx)
+		result.DisplayName = pointer.From(item.Name)
+
+		id, err := zones.ParseDnsZoneIDInsensitively(pointer.From(item.Id))
+		if err != nil {
+			sdk.SetErrorDiagnosticAndPushListResult(result, push, "parsing DNS Zone ID", err)
+			return
+		}
+
+		meta := sdk.NewResourceMetaData(metadata.Client, r)
+		meta.SetID(id)
+
+		if err := r.flatten(ctx, meta, id, &item); err != nil {
+			sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("encoding `%s` resource data", r.ResourceType()), err)
+			return
+		}
+
+		sdk.EncodeListResult(ctx, meta.ResourceData, &result)
+		push(result)
+	})
+}
+
+// listDnsZones lists the DNS Zones within the Resource Group, or within the Subscription when no Resource Group is specified
+func listDnsZones(ctx context.Context, client *zones.ZonesClient, subscriptionId string, resourceGroupName string) ([]zones.Zone, error) {
+	if resourceGroupName != "" {
+		resp, err := client.ListByResourceGroupComplete(ctx, commonids.NewResourceGroupID(subscriptionId, resourceGroupName), zones.DefaultListByResourceGroupOperationOptions())
+		if err != nil {
+			return nil, err
+		}
+
+		return resp.Items, nil
+	}
+
+	resp, err := client.ListComplete(ctx, commonids.NewSubscriptionID(subscriptionId), zones.DefaultListOperationOptions())
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Items, nil
+}
@@ -16,7 +16,6 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2023-12-15-preview/namespaces"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2025-02-15/topics"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/preflight"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -24,6 +23,8 @@ import (
 
 var _ sdk.ResourceWithUpdate = EventGridNamespaceResource{}
 
+var _ sdk.ResourceWithPreflight = EventGridNamespaceResource{}
+
 type EventGridNamespaceResource struct{}
 
 type EventGridNamespaceResourceModel struct {
@@ -214,39 +215,25 @@ func (r EventGridNamespaceResource) Attributes() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{}
 }
 
-func (r EventGridNamespaceResource) CustomizeDiff() sdk.ResourceFunc {
-	return sdk.ResourceFunc{
-		Timeout: 5 * time.Minute,
-		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
-			if metadata.ResourceDiff == nil {
-				return nil
-			}
-
-			if metadata.Client.Features.EnhancedValidation.PreflightEnabled {
-				if len(metadata.ResourceDiff.GetChangedKeysPrefix("")) > 0 || metadata.ResourceDiff.Id() == "" {
-					var model EventGridNamespaceResourceModel
-					if err := metadata.DecodeDiff(&model); err != nil {
-						return err
-					}
-
-					req, err := expandCreateForEventGridNamespace(model)
-					if err != nil {
-						return err
-					}
+func (r EventGridNamespaceResource) PreflightPayload() sdk.PreflightPayloadFunc {
+	return func(ctx context.Context, metadata sdk.ResourceMetaData) (*sdk.PreflightPayload, error) {
+		var model EventGridNamespaceResourceModel
+		if err := metadata.DecodeDiff(&model); err != nil {
+			return nil, err
+		}
 
-					id := namespaces.NewNamespaceID(metadata.Client.Account.SubscriptionId, model.ResourceGroup, model.Name)
-					preflightValidate, err := preflight.NewValidationRequest(pointer.To(model.Location), pointer.To(id), "2023-12-15-preview", req)
-					if err != nil {
-						return fmt.Errorf("constructing preflight validation request: %w", err)
-					}
+		req, err := expandCreateForEventGridNamespace(model)
+		if err != nil {
+			return nil, err
+		}
 
-					if err = preflightValidate.ValidateResource(ctx, metadata); err != nil {
-						return err
-					}
-				}
-			}
-			return nil
-		},
+		id := namespaces.NewNamespaceID(metadata.Client.Account.SubscriptionId, model.ResourceGroup, model.Name)
+		return &sdk.PreflightPayload{
+			Location:   pointer.To(model.Location),
+			ResourceId: pointer.To(id),
+			ApiVersion: "2023-12-15-preview",
+			Properties: req,
+		}, nil
 	}
 }
 
@@ -0,0 +1,107 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package eventhub
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-azure-helpers/eventhub"
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.EphemeralResource = &EventHubSharedAccessSignatureEphemeralResource{}
+
+func NewEventHubSharedAccessSignatureEphemeralResource() ephemeral.EphemeralResource {
+	return &EventHubSharedAccessSignatureEphemeralResource{}
+}
+
+type EventHubSharedAccessSignatureEphemeralResource struct {
+	sdk.EphemeralResourceMetadata
+}
+
+type EventHubSharedAccessSignatureEphemeralResourceModel struct {
+	ConnectionString types.String `tfsdk:"connection_string"`
+	Expiry           types.String `tfsdk:"expiry"`
+	Sas              types.String `tfsdk:"sas"`
+}
+
+func (e *EventHubSharedAccessSignatureEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "azurerm_eventhub_sas"
+}
+
+func (e *EventHubSharedAccessSignatureEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	e.Defaults(req, resp)
+}
+
+func (e *EventHubSharedAccessSignatureEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"connection_string": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.StringIsNotEmpty,
+					},
+				},
+			},
+
+			// Always in UTC and must be ISO-8601 format
+			"expiry": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validate.ISO8601DateTime,
+					},
+				},
+			},
+
+			"sas": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (e *EventHubSharedAccessSignatureEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data EventHubSharedAccessSignatureEphemeralResourceModel
+
+	if ok := e.DecodeOpen(ctx, req, resp, &data); !ok {
+		return
+	}
+
+	kvp, err := eventhub.ParseEventHubSASConnectionString(data.ConnectionString.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, "parsing `connection_string`", err)
+		return
+	}
+
+	sharedAccessKeyName := kvp[connStringSharedAccessKeyNameKey]
+	sharedAccessKey := kvp[connStringSharedAccessKeyKey]
+	endpoint := kvp[connStringEndpointKey]
+	entityPath := kvp[connStringEntityPathKey]
+	endpointUrl, err := eventhub.ComputeEventHubSASConnectionUrl(endpoint, entityPath)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, "computing Event Hub SAS connection URL", err)
+		return
+	}
+
+	sasToken, err := eventhub.ComputeEventHubSASToken(sharedAccessKeyName, sharedAccessKey, *endpointUrl, data.Expiry.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, "computing Event Hub SAS Token", err)
+		return
+	}
+
+	data.Sas = types.StringValue(eventhub.ComputeEventHubSASConnectionString(sasToken))
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
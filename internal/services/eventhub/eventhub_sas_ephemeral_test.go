@@ -0,0 +1,95 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package eventhub_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type EventHubSharedAccessSignatureEphemeral struct{}
+
+func TestAccEphemeralEventHubSharedAccessSignature_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "ephemeral.azurerm_eventhub_sas", "test")
+	r := EventHubSharedAccessSignatureEphemeral{}
+	utcNow := time.Now().UTC()
+	endDate := utcNow.Add(time.Hour * 24).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.10.0-rc1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		ProtoV6ProviderFactories: framework.ProtoV6ProviderFactoriesInit(context.Background(), "azurerm", "echo"),
+		Steps: []resource.TestStep{
+			{
+				Config: r.basic(data, endDate),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("sas"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("expiry"), knownvalue.StringExact(endDate)),
+				},
+			},
+		},
+	})
+}
+
+func (EventHubSharedAccessSignatureEphemeral) basic(data acceptance.TestData, endDate string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-ehn-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_eventhub_namespace" "test" {
+  name                = "acctest-ehn-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Basic"
+}
+
+resource "azurerm_eventhub" "test" {
+  name              = "acctest-eh-%[1]d"
+  namespace_id      = azurerm_eventhub_namespace.test.id
+  partition_count   = 1
+  message_retention = 1
+}
+
+resource "azurerm_eventhub_authorization_rule" "test" {
+  name                = "acctest-ehar-%[1]d"
+  namespace_name      = azurerm_eventhub_namespace.test.name
+  eventhub_name       = azurerm_eventhub.test.name
+  resource_group_name = azurerm_resource_group.test.name
+
+  listen = true
+  send   = true
+  manage = true
+}
+
+ephemeral "azurerm_eventhub_sas" "test" {
+  connection_string = azurerm_eventhub_authorization_rule.test.primary_connection_string
+  expiry            = "%[3]s"
+}
+
+provider "echo" {
+  data = ephemeral.azurerm_eventhub_sas.test
+}
+
+resource "echo" "test" {}
+`, data.RandomInteger, data.Locations.Primary, endDate)
+}
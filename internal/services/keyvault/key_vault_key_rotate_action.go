@@ -0,0 +1,122 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/keyvault"
+	"github.com/hashicorp/go-azure-sdk/data-plane/keyvault/7-4/keys"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type KeyVaultKeyRotateAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &KeyVaultKeyRotateAction{}
+
+func newKeyVaultKeyRotateAction() action.Action {
+	return &KeyVaultKeyRotateAction{}
+}
+
+type KeyVaultKeyRotateActionModel struct {
+	KeyVaultKeyId types.String `tfsdk:"key_vault_key_id"`
+	Timeout       types.String `tfsdk:"timeout"`
+}
+
+func (k *KeyVaultKeyRotateAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"key_vault_key_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the Key Vault Key to rotate. Either a versioned or versionless ID may be specified.",
+				MarkdownDescription: "The ID of the Key Vault Key to rotate. Either a versioned or versionless ID may be specified.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: keyvault.ValidateNestedItemID(keyvault.VersionTypeAny, keyvault.NestedItemTypeKey),
+					},
+				},
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the action to complete. Defaults to `5m`.",
+				MarkdownDescription: "Timeout duration for the action to complete. Defaults to `5m`.",
+			},
+		},
+	}
+}
+
+func (k *KeyVaultKeyRotateAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_key_vault_key_rotate"
+}
+
+func (k *KeyVaultKeyRotateAction) Invoke(ctx context.Context, request action.InvokeRequest, resp *action.InvokeResponse) {
+	model := KeyVaultKeyRotateActionModel{}
+
+	resp.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctxTimeout := 5 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(resp, "parsing `timeout`", err)
+			return
+		}
+
+		ctxTimeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	id, err := keyvault.ParseNestedItemID(model.KeyVaultKeyId.ValueString(), keyvault.VersionTypeAny, keyvault.NestedItemTypeKey)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, "parsing id", err)
+		return
+	}
+
+	client := k.Client.KeyVault.DataPlaneKeyVaultClient.Keys.Clone(id.KeyVaultBaseURL)
+	keyId := keys.NewKeyID(id.KeyVaultBaseURL, id.Name)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("rotating Key %q in %s", id.Name, id.KeyVaultBaseURL),
+	})
+
+	result, err := client.RotateKey(ctx, keyId)
+	if err != nil {
+		if response.WasForbidden(result.HttpResponse) {
+			sdk.SetResponseErrorDiagnostic(resp, "running action", fmt.Sprintf("current client lacks the `rotate` permission for Key %q in %s: %+v", id.Name, id.KeyVaultBaseURL, err))
+			return
+		}
+		sdk.SetResponseErrorDiagnostic(resp, "running action", fmt.Sprintf("rotating Key %q in %s: %+v", id.Name, id.KeyVaultBaseURL, err))
+		return
+	}
+
+	if result.Model == nil || result.Model.Key == nil || result.Model.Key.Kid == nil {
+		sdk.SetResponseErrorDiagnostic(resp, "running action", fmt.Sprintf("rotating Key %q in %s: the new key version ID was nil", id.Name, id.KeyVaultBaseURL))
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("rotated Key %q in %s, the new version ID is %q", id.Name, id.KeyVaultBaseURL, pointer.From(result.Model.Key.Kid)),
+	})
+}
+
+func (k *KeyVaultKeyRotateAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	k.Defaults(ctx, request, response)
+}
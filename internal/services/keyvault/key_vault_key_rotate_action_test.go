@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type KeyVaultKeyRotateAction struct{}
+
+func TestAccKeyVaultKeyRotateAction_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_key_rotate", "test")
+	a := KeyVaultKeyRotateAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: KeyVaultKeyResource{}.basicEC(data),
+			},
+			{
+				Config: a.basic(data),
+			},
+		},
+	})
+}
+
+func (KeyVaultKeyRotateAction) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "terraform_data" "trigger" {
+  input = azurerm_key_vault_key.test.versionless_id
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_key_vault_key_rotate.test]
+    }
+  }
+}
+
+action "azurerm_key_vault_key_rotate" "test" {
+  config {
+    key_vault_key_id = azurerm_key_vault_key.test.versionless_id
+  }
+}
+`, KeyVaultKeyResource{}.basicEC(data))
+}
@@ -65,43 +65,25 @@ func (r KeyVaultListResource) List(ctx context.Context, request list.ListRequest
 		results = resp.Items
 	}
 
-	deadline, ok := ctx.Deadline()
-	if !ok {
-		sdk.SetResponseErrorDiagnostic(stream, "internal-error", fmt.Errorf("context had no deadline"))
-		return
-	}
+	stream.Results = sdk.ListResultsConcurrently(ctx, request, metadata, results, func(ctx context.Context, vault vaults.Vault, push func(list.ListResult) bool) {
+		result := request.NewListResult(ctx)
+		result.DisplayName = pointer.From(vault.Name)
 
-	stream.Results = func(push func(list.ListResult) bool) {
-		ctx, cancel := context.WithDeadline(context.Background(), deadline)
-		defer cancel()
-
-		for _, vault := range results {
-			result := request.NewListResult(ctx)
-			result.DisplayName = pointer.From(vault.Name)
-
-			id, err := commonids.ParseKeyVaultIDInsensitively(pointer.From(vault.Id))
-			if err != nil {
-				sdk.SetErrorDiagnosticAndPushListResult(result, push, "parsing Key Vault ID", err)
-				return
-			}
-
-			rd := resourceKeyVault().Data(&terraform.InstanceState{})
-			rd.SetId(id.ID())
-
-			if err := resourceKeyVaultFlatten(ctx, metadata.Client.KeyVault.ManagementClient, rd, id, &vault, request.IncludeResource); err != nil {
-				sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("encoding `%s` resource data", keyVaultResourceName), err)
-				return
-			}
-
-			sdk.EncodeListResult(ctx, rd, &result)
-			if result.Diagnostics.HasError() {
-				push(result)
-				return
-			}
-
-			if !push(result) {
-				return
-			}
+		id, err := commonids.ParseKeyVaultIDInsensitively(pointer.From(vault.Id))
+		if err != nil {
+			sdk.SetErrorDiagnosticAndPushListResult(result, push, "parsing Key Vault ID", err)
+			return
 		}
-	}
+
+		rd := resourceKeyVault().Data(&terraform.InstanceState{})
+		rd.SetId(id.ID())
+
+		if err := resourceKeyVaultFlatten(ctx, metadata.Client.KeyVault.ManagementClient, rd, id, &vault, request.IncludeResource); err != nil {
+			sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("encoding `%s` resource data", keyVaultResourceName), err)
+			return
+		}
+
+		sdk.EncodeListResult(ctx, rd, &result)
+		push(result)
+	})
 }
@@ -0,0 +1,181 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/keyvault"
+	"github.com/hashicorp/go-azure-sdk/data-plane/keyvault/7-4/secrets"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type KeyVaultSecretRotateAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &KeyVaultSecretRotateAction{}
+
+func newKeyVaultSecretRotateAction() action.Action {
+	return &KeyVaultSecretRotateAction{}
+}
+
+type KeyVaultSecretRotateActionModel struct {
+	KeyVaultSecretId types.String `tfsdk:"key_vault_secret_id"`
+	Value            types.String `tfsdk:"value"`
+	ContentType      types.String `tfsdk:"content_type"`
+	ExpirationDate   types.String `tfsdk:"expiration_date"`
+	Timeout          types.String `tfsdk:"timeout"`
+}
+
+func (k *KeyVaultSecretRotateAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"key_vault_secret_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the Key Vault Secret for which a new version should be created. Either a versioned or versionless ID may be specified.",
+				MarkdownDescription: "The ID of the Key Vault Secret for which a new version should be created. Either a versioned or versionless ID may be specified.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: keyvault.ValidateNestedItemID(keyvault.VersionTypeAny, keyvault.NestedItemTypeSecret),
+					},
+				},
+			},
+
+			"value": schema.StringAttribute{
+				Required:            true,
+				WriteOnly:           true,
+				Description:         "The value of the new version of the Key Vault Secret.",
+				MarkdownDescription: "The value of the new version of the Key Vault Secret.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.StringIsNotEmpty,
+					},
+				},
+			},
+
+			"content_type": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The content type of the new version of the Key Vault Secret. Defaults to the content type of the current version.",
+				MarkdownDescription: "The content type of the new version of the Key Vault Secret. Defaults to the content type of the current version.",
+			},
+
+			"expiration_date": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The expiration date of the new version of the Key Vault Secret, as an RFC3339 date.",
+				MarkdownDescription: "The expiration date of the new version of the Key Vault Secret, as an RFC3339 date.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.IsRFC3339Time,
+					},
+				},
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the action to complete. Defaults to `5m`.",
+				MarkdownDescription: "Timeout duration for the action to complete. Defaults to `5m`.",
+			},
+		},
+	}
+}
+
+func (k *KeyVaultSecretRotateAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_key_vault_secret_rotate"
+}
+
+func (k *KeyVaultSecretRotateAction) Invoke(ctx context.Context, request action.InvokeRequest, resp *action.InvokeResponse) {
+	model := KeyVaultSecretRotateActionModel{}
+
+	resp.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctxTimeout := 5 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(resp, "parsing `timeout`", err)
+			return
+		}
+
+		ctxTimeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	id, err := keyvault.ParseNestedItemID(model.KeyVaultSecretId.ValueString(), keyvault.VersionTypeAny, keyvault.NestedItemTypeSecret)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, "parsing id", err)
+		return
+	}
+
+	client := k.Client.KeyVault.DataPlaneKeyVaultClient.Secrets.Clone(id.KeyVaultBaseURL)
+
+	// the content type and tags are carried over from the current version, since a new version is otherwise created without them
+	existing, err := client.GetSecret(ctx, secrets.NewSecretversionID(id.KeyVaultBaseURL, id.Name, ""))
+	if err != nil {
+		if response.WasNotFound(existing.HttpResponse) {
+			sdk.SetResponseErrorDiagnostic(resp, "running action", fmt.Sprintf("Secret %q was not found in %s", id.Name, id.KeyVaultBaseURL))
+			return
+		}
+		sdk.SetResponseErrorDiagnostic(resp, "running action", fmt.Sprintf("retrieving Secret %q in %s: %+v", id.Name, id.KeyVaultBaseURL, err))
+		return
+	}
+
+	parameters := secrets.SecretSetParameters{
+		Value: model.Value.ValueString(),
+	}
+
+	if existing.Model != nil {
+		parameters.ContentType = existing.Model.ContentType
+		parameters.Tags = existing.Model.Tags
+	}
+
+	if !model.ContentType.IsNull() {
+		parameters.ContentType = pointer.To(model.ContentType.ValueString())
+	}
+
+	if v := model.ExpirationDate.ValueString(); v != "" {
+		expirationDate, _ := time.Parse(time.RFC3339, v) // validated by schema
+		parameters.Attributes = &secrets.SecretAttributes{
+			Exp: pointer.To(expirationDate.Unix()),
+		}
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("setting a new version of Secret %q in %s", id.Name, id.KeyVaultBaseURL),
+	})
+
+	result, err := client.SetSecret(ctx, secrets.NewSecretID(id.KeyVaultBaseURL, id.Name), parameters)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, "running action", fmt.Sprintf("setting a new version of Secret %q in %s: %+v", id.Name, id.KeyVaultBaseURL, err))
+		return
+	}
+
+	if result.Model == nil || result.Model.Id == nil {
+		sdk.SetResponseErrorDiagnostic(resp, "running action", fmt.Sprintf("setting a new version of Secret %q in %s: the new version ID was nil", id.Name, id.KeyVaultBaseURL))
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("set a new version of Secret %q in %s, the new version ID is %q", id.Name, id.KeyVaultBaseURL, pointer.From(result.Model.Id)),
+	})
+}
+
+func (k *KeyVaultSecretRotateAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	k.Defaults(ctx, request, response)
+}
@@ -0,0 +1,105 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type KeyVaultSecretRotateAction struct{}
+
+func TestAccKeyVaultSecretRotateAction_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_secret_rotate", "test")
+	a := KeyVaultSecretRotateAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: KeyVaultSecretResource{}.writeOnlyValue(data, "rick-and-morty", 1),
+			},
+			{
+				Config: a.basic(data),
+			},
+		},
+	})
+}
+
+func TestAccKeyVaultSecretRotateAction_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_secret_rotate", "test")
+	a := KeyVaultSecretRotateAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: KeyVaultSecretResource{}.writeOnlyValue(data, "rick-and-morty", 1),
+			},
+			{
+				Config: a.complete(data),
+			},
+		},
+	})
+}
+
+func (KeyVaultSecretRotateAction) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "terraform_data" "trigger" {
+  input = azurerm_key_vault_secret.test.versionless_id
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_key_vault_secret_rotate.test]
+    }
+  }
+}
+
+action "azurerm_key_vault_secret_rotate" "test" {
+  config {
+    key_vault_secret_id = azurerm_key_vault_secret.test.versionless_id
+    value               = "szechuan"
+  }
+}
+`, KeyVaultSecretResource{}.writeOnlyValue(data, "rick-and-morty", 1))
+}
+
+func (KeyVaultSecretRotateAction) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "terraform_data" "trigger" {
+  input = azurerm_key_vault_secret.test.id
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_key_vault_secret_rotate.test]
+    }
+  }
+}
+
+action "azurerm_key_vault_secret_rotate" "test" {
+  config {
+    key_vault_secret_id = azurerm_key_vault_secret.test.id
+    value               = "szechuan"
+    content_type        = "text/plain"
+    expiration_date     = "2035-01-01T00:00:00Z"
+  }
+}
+`, KeyVaultSecretResource{}.writeOnlyValue(data, "rick-and-morty", 1))
+}
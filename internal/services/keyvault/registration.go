@@ -64,7 +64,10 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 }
 
 func (r Registration) Actions() []func() action.Action {
-	return []func() action.Action{}
+	return []func() action.Action{
+		newKeyVaultKeyRotateAction,
+		newKeyVaultSecretRotateAction,
+	}
 }
 
 func (r Registration) DataSources() []sdk.DataSource {
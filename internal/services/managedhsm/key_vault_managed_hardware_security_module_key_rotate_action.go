@@ -0,0 +1,127 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package managedhsm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managedhsm/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managedhsm/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KeyVaultMHSMKeyRotateAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &KeyVaultMHSMKeyRotateAction{}
+
+func newKeyVaultMHSMKeyRotateAction() action.Action {
+	return &KeyVaultMHSMKeyRotateAction{}
+}
+
+type KeyVaultMHSMKeyRotateActionModel struct {
+	ManagedHSMKeyId types.String `tfsdk:"managed_hsm_key_id"`
+	Timeout         types.String `tfsdk:"timeout"`
+}
+
+func (k *KeyVaultMHSMKeyRotateAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"managed_hsm_key_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the Managed HSM Key to rotate.",
+				MarkdownDescription: "The ID of the Managed HSM Key to rotate.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validate.ManagedHSMDataPlaneVersionlessKeyID,
+					},
+				},
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the action to complete. Defaults to `5m`.",
+				MarkdownDescription: "Timeout duration for the action to complete. Defaults to `5m`.",
+			},
+		},
+	}
+}
+
+func (k *KeyVaultMHSMKeyRotateAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_key_vault_managed_hardware_security_module_key_rotate"
+}
+
+func (k *KeyVaultMHSMKeyRotateAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := k.Client.ManagedHSMs.DataPlaneKeysClient
+
+	model := KeyVaultMHSMKeyRotateActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctxTimeout := 5 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+
+		ctxTimeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	domainSuffix, ok := k.Client.Account.Environment.ManagedHSM.DomainSuffix()
+	if !ok {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("could not determine Managed HSM domain suffix for environment %q", k.Client.Account.Environment.Name))
+		return
+	}
+
+	id, err := parse.ManagedHSMDataPlaneVersionlessKeyID(model.ManagedHSMKeyId.ValueString(), domainSuffix)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("rotating %s", id),
+	})
+
+	result, err := client.RotateKey(ctx, id.BaseUri(), id.KeyName)
+	if err != nil {
+		if utils.ResponseWasForbidden(result.Response) {
+			sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("current client lacks the `rotate` permission for %s: %+v", id, err))
+			return
+		}
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("rotating %s: %+v", id, err))
+		return
+	}
+
+	if result.Key == nil || result.Key.Kid == nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("rotating %s: the new key version ID was nil", id))
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("rotated %s, the new version ID is %q", id, pointer.From(result.Key.Kid)),
+	})
+}
+
+func (k *KeyVaultMHSMKeyRotateAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	k.Defaults(ctx, request, response)
+}
@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package managedhsm_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type KeyVaultMHSMKeyRotateAction struct{}
+
+func testAccKeyVaultMHSMKeyRotateAction_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_hardware_security_module_key_rotate", "test")
+	a := KeyVaultMHSMKeyRotateAction{}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: KeyVaultMHSMKeyTestResource{}.basic(data),
+			},
+			{
+				Config: a.basic(data),
+			},
+		},
+	})
+}
+
+func (KeyVaultMHSMKeyRotateAction) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "terraform_data" "trigger" {
+  input = azurerm_key_vault_managed_hardware_security_module_key.test.id
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_key_vault_managed_hardware_security_module_key_rotate.test]
+    }
+  }
+}
+
+action "azurerm_key_vault_managed_hardware_security_module_key_rotate" "test" {
+  config {
+    managed_hsm_key_id = azurerm_key_vault_managed_hardware_security_module_key.test.id
+  }
+}
+`, KeyVaultMHSMKeyTestResource{}.basic(data))
+}
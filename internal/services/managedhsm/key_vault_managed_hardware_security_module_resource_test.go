@@ -48,6 +48,7 @@ func TestAccKeyVaultManagedHardwareSecurityModule_sequential(t *testing.T) {
 			"purge":              testAccKeyVaultHSMKey_purge,
 			"softDeleteRecovery": testAccKeyVaultHSMKey_softDeleteRecovery,
 			"rotationPolicy":     testAccMHSMKeyRotationPolicy_all,
+			"rotateAction":       testAccKeyVaultMHSMKeyRotateAction_basic,
 			"data_source":        testAccKeyVaultMHSMKeyDataSource_basic,
 		},
 	})
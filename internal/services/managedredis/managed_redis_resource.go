@@ -21,7 +21,6 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/redisenterprise/2025-07-01/databases"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/redisenterprise/2025-07-01/redisenterprise"
 	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/preflight"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managedredis/custompollers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managedredis/validate"
@@ -43,6 +42,8 @@ type ManagedRedisResource struct{}
 
 var _ sdk.ResourceWithUpdate = ManagedRedisResource{}
 
+var _ sdk.ResourceWithPreflight = ManagedRedisResource{}
+
 type ManagedRedisResourceModel struct {
 	Name              string `tfschema:"name"`
 	ResourceGroupName string `tfschema:"resource_group_name"`
@@ -651,6 +652,31 @@ func (r ManagedRedisResource) Delete() sdk.ResourceFunc {
 	}
 }
 
+func (r ManagedRedisResource) PreflightPayload() sdk.PreflightPayloadFunc {
+	return func(ctx context.Context, metadata sdk.ResourceMetaData) (*sdk.PreflightPayload, error) {
+		var model ManagedRedisResourceModel
+		if err := metadata.DecodeDiff(&model); err != nil {
+			return nil, err
+		}
+
+		req, err := expandCreateForManagedRedis(model)
+		if err != nil {
+			return nil, err
+		}
+
+		resId := redisenterprise.NewRedisEnterpriseID(metadata.Client.Account.SubscriptionId, model.ResourceGroupName, model.Name)
+		return &sdk.PreflightPayload{
+			Location:   pointer.To(model.Location),
+			ResourceId: pointer.To(resId),
+			// Microsoft.Cache hosts both "redis" (classic) and "redisEnterprise" products under the same
+			// provider. The preflight API uses "redis" as the type discriminator for this resource.
+			ResourceTypeOverride: "redis",
+			ApiVersion:           "2025-07-01",
+			Properties:           req,
+		}, nil
+	}
+}
+
 func (r ManagedRedisResource) CustomizeDiff() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 5 * time.Minute,
@@ -664,27 +690,6 @@ func (r ManagedRedisResource) CustomizeDiff() sdk.ResourceFunc {
 				return err
 			}
 
-			if metadata.Client.Features.EnhancedValidation.PreflightEnabled {
-				// Only perform preflight validation if there are changes. This avoids validation failures and
-				// additional API calls for resources that are unchanged between plan invocations
-				if len(metadata.ResourceDiff.GetChangedKeysPrefix("")) > 0 || metadata.ResourceDiff.Id() == "" {
-					req, err := expandCreateForManagedRedis(model)
-					if err != nil {
-						return err
-					}
-
-					resId := redisenterprise.NewRedisEnterpriseID(metadata.Client.Account.SubscriptionId, model.ResourceGroupName, model.Name)
-					preflightValidate, err := preflight.NewValidationRequestWithTypeOverride(pointer.To(model.Location), pointer.To(resId), "redis", "2025-07-01", req)
-					if err != nil {
-						return fmt.Errorf("constructing preflight validation request: %w", err)
-					}
-
-					if err = preflightValidate.ValidateResource(ctx, metadata); err != nil {
-						return err
-					}
-				}
-			}
-
 			if metadata.ResourceDiff.Id() == "" && len(model.DefaultDatabase) == 0 {
 				return fmt.Errorf("`default_database` must be provided when creating a new resource")
 			}
@@ -0,0 +1,231 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package mssql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/databases"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type MsSqlDatabaseExportAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &MsSqlDatabaseExportAction{}
+
+func newMsSqlDatabaseExportAction() action.Action {
+	return &MsSqlDatabaseExportAction{}
+}
+
+type MsSqlDatabaseExportActionModel struct {
+	DatabaseId                 types.String `tfsdk:"database_id"`
+	StorageUri                 types.String `tfsdk:"storage_uri"`
+	StorageKey                 types.String `tfsdk:"storage_key"`
+	StorageKeyType             types.String `tfsdk:"storage_key_type"`
+	AdministratorLogin         types.String `tfsdk:"administrator_login"`
+	AdministratorLoginPassword types.String `tfsdk:"administrator_login_password"`
+	AuthenticationType         types.String `tfsdk:"authentication_type"`
+	StorageAccountId           types.String `tfsdk:"storage_account_id"`
+	Timeout                    types.String `tfsdk:"timeout"`
+}
+
+func (m *MsSqlDatabaseExportAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"database_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the database to export.",
+				MarkdownDescription: "The ID of the database to export.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: commonids.ValidateSqlDatabaseID,
+					},
+				},
+			},
+
+			"storage_uri": schema.StringAttribute{
+				Required:            true,
+				Description:         "The URI of the blob to which the BACPAC file should be written, for example `https://example.blob.core.windows.net/bacpacs/example.bacpac`.",
+				MarkdownDescription: "The URI of the blob to which the BACPAC file should be written, for example `https://example.blob.core.windows.net/bacpacs/example.bacpac`.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.IsURLWithHTTPS,
+					},
+				},
+			},
+
+			"storage_key": schema.StringAttribute{
+				Required:            true,
+				WriteOnly:           true,
+				Description:         "The Storage Account access key or Shared Access Signature used to write the BACPAC file.",
+				MarkdownDescription: "The Storage Account access key or Shared Access Signature used to write the BACPAC file.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.StringIsNotEmpty,
+					},
+				},
+			},
+
+			"storage_key_type": schema.StringAttribute{
+				Required:            true,
+				Description:         "The type of the `storage_key`. Possible values are `SharedAccessKey` and `StorageAccessKey`.",
+				MarkdownDescription: "The type of the `storage_key`. Possible values are `SharedAccessKey` and `StorageAccessKey`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(databases.PossibleValuesForStorageKeyType()...),
+				},
+			},
+
+			"administrator_login": schema.StringAttribute{
+				Required:            true,
+				Description:         "The login used to connect to the database.",
+				MarkdownDescription: "The login used to connect to the database.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.StringIsNotEmpty,
+					},
+				},
+			},
+
+			"administrator_login_password": schema.StringAttribute{
+				Required:            true,
+				WriteOnly:           true,
+				Description:         "The password of the `administrator_login`.",
+				MarkdownDescription: "The password of the `administrator_login`.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.StringIsNotEmpty,
+					},
+				},
+			},
+
+			"authentication_type": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The type of authentication used to connect to the database. Possible values are `ADPassword` and `Sql`. Defaults to `Sql`.",
+				MarkdownDescription: "The type of authentication used to connect to the database. Possible values are `ADPassword` and `Sql`. Defaults to `Sql`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("ADPassword", "Sql"),
+				},
+			},
+
+			"storage_account_id": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The ID of the Storage Account to which the BACPAC file is written. When specified the export is performed over a Private Link connection.",
+				MarkdownDescription: "The ID of the Storage Account to which the BACPAC file is written. When specified the export is performed over a Private Link connection.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: commonids.ValidateStorageAccountID,
+					},
+				},
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the action to complete. Defaults to `2h`.",
+				MarkdownDescription: "Timeout duration for the action to complete. Defaults to `2h`.",
+			},
+		},
+	}
+}
+
+func (m *MsSqlDatabaseExportAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_mssql_database_export"
+}
+
+func (m *MsSqlDatabaseExportAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := m.Client.MSSQL.DatabasesClient
+
+	model := MsSqlDatabaseExportActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	timeout := 2 * time.Hour
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+		timeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	id, err := commonids.ParseSqlDatabaseID(model.DatabaseId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	authenticationType := "Sql"
+	if v := model.AuthenticationType.ValueString(); v != "" {
+		authenticationType = v
+	}
+
+	input := databases.ExportDatabaseDefinition{
+		StorageUri:                 model.StorageUri.ValueString(),
+		StorageKey:                 model.StorageKey.ValueString(),
+		StorageKeyType:             databases.StorageKeyType(model.StorageKeyType.ValueString()),
+		AdministratorLogin:         model.AdministratorLogin.ValueString(),
+		AdministratorLoginPassword: model.AdministratorLoginPassword.ValueString(),
+		AuthenticationType:         pointer.To(authenticationType),
+	}
+
+	if v := model.StorageAccountId.ValueString(); v != "" {
+		serverId := commonids.NewSqlServerID(id.SubscriptionId, id.ResourceGroupName, id.ServerName)
+		input.NetworkIsolation = &databases.NetworkIsolationSettings{
+			StorageAccountResourceId: pointer.To(v),
+			SqlServerResourceId:      pointer.To(serverId.ID()),
+		}
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("exporting %s to %q", id, input.StorageUri),
+	})
+
+	resp, err := client.Export(ctx, *id, input)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Errorf("exporting %s: %w", id, err))
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("export of %s accepted, waiting for the export to complete", id),
+	})
+
+	if err := resp.Poller.PollUntilDone(ctx); err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Errorf("waiting for the export of %s: %w", id, err))
+		return
+	}
+
+	blobUri := input.StorageUri
+	result := databases.ImportExportOperationResult{}
+	if err := resp.Poller.FinalResult(&result); err == nil && result.Properties != nil && result.Properties.BlobUri != nil {
+		blobUri = *result.Properties.BlobUri
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("exported %s to %q", id, blobUri),
+	})
+}
+
+func (m *MsSqlDatabaseExportAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	m.Defaults(ctx, request, response)
+}
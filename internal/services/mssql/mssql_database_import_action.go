@@ -0,0 +1,288 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package mssql
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/servers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type MsSqlDatabaseImportAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &MsSqlDatabaseImportAction{}
+
+func newMsSqlDatabaseImportAction() action.Action {
+	return &MsSqlDatabaseImportAction{}
+}
+
+type MsSqlDatabaseImportActionModel struct {
+	ServerId                   types.String `tfsdk:"server_id"`
+	DatabaseName               types.String `tfsdk:"database_name"`
+	StorageUri                 types.String `tfsdk:"storage_uri"`
+	StorageKey                 types.String `tfsdk:"storage_key"`
+	StorageKeyType             types.String `tfsdk:"storage_key_type"`
+	AdministratorLogin         types.String `tfsdk:"administrator_login"`
+	AdministratorLoginPassword types.String `tfsdk:"administrator_login_password"`
+	AuthenticationType         types.String `tfsdk:"authentication_type"`
+	StorageAccountId           types.String `tfsdk:"storage_account_id"`
+	Edition                    types.String `tfsdk:"edition"`
+	SkuName                    types.String `tfsdk:"sku_name"`
+	MaxSizeGb                  types.Int64  `tfsdk:"max_size_gb"`
+	Timeout                    types.String `tfsdk:"timeout"`
+}
+
+func (m *MsSqlDatabaseImportAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the server on which the database should be created.",
+				MarkdownDescription: "The ID of the server on which the database should be created.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: commonids.ValidateSqlServerID,
+					},
+				},
+			},
+
+			"database_name": schema.StringAttribute{
+				Required:            true,
+				Description:         "The name of the database to create from the BACPAC file. The database must not already exist.",
+				MarkdownDescription: "The name of the database to create from the BACPAC file. The database must not already exist.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validate.ValidateMsSqlDatabaseName,
+					},
+				},
+			},
+
+			"storage_uri": schema.StringAttribute{
+				Required:            true,
+				Description:         "The URI of the BACPAC file to import, for example `https://example.blob.core.windows.net/bacpacs/example.bacpac`.",
+				MarkdownDescription: "The URI of the BACPAC file to import, for example `https://example.blob.core.windows.net/bacpacs/example.bacpac`.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.IsURLWithHTTPS,
+					},
+				},
+			},
+
+			"storage_key": schema.StringAttribute{
+				Required:            true,
+				WriteOnly:           true,
+				Description:         "The Storage Account access key or Shared Access Signature used to read the BACPAC file.",
+				MarkdownDescription: "The Storage Account access key or Shared Access Signature used to read the BACPAC file.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.StringIsNotEmpty,
+					},
+				},
+			},
+
+			"storage_key_type": schema.StringAttribute{
+				Required:            true,
+				Description:         "The type of the `storage_key`. Possible values are `SharedAccessKey` and `StorageAccessKey`.",
+				MarkdownDescription: "The type of the `storage_key`. Possible values are `SharedAccessKey` and `StorageAccessKey`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(servers.PossibleValuesForStorageKeyType()...),
+				},
+			},
+
+			"administrator_login": schema.StringAttribute{
+				Required:            true,
+				Description:         "The login used to connect to the server.",
+				MarkdownDescription: "The login used to connect to the server.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.StringIsNotEmpty,
+					},
+				},
+			},
+
+			"administrator_login_password": schema.StringAttribute{
+				Required:            true,
+				WriteOnly:           true,
+				Description:         "The password of the `administrator_login`.",
+				MarkdownDescription: "The password of the `administrator_login`.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.StringIsNotEmpty,
+					},
+				},
+			},
+
+			"authentication_type": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The type of authentication used to connect to the server. Possible values are `ADPassword` and `Sql`. Defaults to `Sql`.",
+				MarkdownDescription: "The type of authentication used to connect to the server. Possible values are `ADPassword` and `Sql`. Defaults to `Sql`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("ADPassword", "Sql"),
+				},
+			},
+
+			"storage_account_id": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The ID of the Storage Account containing the BACPAC file. When specified the import is performed over a Private Link connection.",
+				MarkdownDescription: "The ID of the Storage Account containing the BACPAC file. When specified the import is performed over a Private Link connection.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: commonids.ValidateStorageAccountID,
+					},
+				},
+			},
+
+			"edition": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The edition of the new database, for example `Standard` or `GeneralPurpose`.",
+				MarkdownDescription: "The edition of the new database, for example `Standard` or `GeneralPurpose`.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.StringIsNotEmpty,
+					},
+				},
+			},
+
+			"sku_name": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The name of the SKU (service objective) of the new database, for example `S0` or `GP_Gen5_2`.",
+				MarkdownDescription: "The name of the SKU (service objective) of the new database, for example `S0` or `GP_Gen5_2`.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.StringIsNotEmpty,
+					},
+				},
+			},
+
+			"max_size_gb": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "The maximum size of the new database in gigabytes.",
+				MarkdownDescription: "The maximum size of the new database in gigabytes.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the action to complete. Defaults to `2h`.",
+				MarkdownDescription: "Timeout duration for the action to complete. Defaults to `2h`.",
+			},
+		},
+	}
+}
+
+func (m *MsSqlDatabaseImportAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_mssql_database_import"
+}
+
+func (m *MsSqlDatabaseImportAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := m.Client.MSSQL.ServersClient
+
+	model := MsSqlDatabaseImportActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	timeout := 2 * time.Hour
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+		timeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	serverId, err := commonids.ParseSqlServerID(model.ServerId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	id := commonids.NewSqlDatabaseID(serverId.SubscriptionId, serverId.ResourceGroupName, serverId.ServerName, model.DatabaseName.ValueString())
+
+	authenticationType := "Sql"
+	if v := model.AuthenticationType.ValueString(); v != "" {
+		authenticationType = v
+	}
+
+	input := servers.ImportNewDatabaseDefinition{
+		DatabaseName:               pointer.To(id.DatabaseName),
+		StorageUri:                 model.StorageUri.ValueString(),
+		StorageKey:                 model.StorageKey.ValueString(),
+		StorageKeyType:             servers.StorageKeyType(model.StorageKeyType.ValueString()),
+		AdministratorLogin:         model.AdministratorLogin.ValueString(),
+		AdministratorLoginPassword: model.AdministratorLoginPassword.ValueString(),
+		AuthenticationType:         pointer.To(authenticationType),
+	}
+
+	if v := model.Edition.ValueString(); v != "" {
+		input.Edition = pointer.To(v)
+	}
+
+	if v := model.SkuName.ValueString(); v != "" {
+		input.ServiceObjectiveName = pointer.To(v)
+	}
+
+	if !model.MaxSizeGb.IsNull() {
+		input.MaxSizeBytes = pointer.To(strconv.FormatInt(model.MaxSizeGb.ValueInt64()*1024*1024*1024, 10))
+	}
+
+	if v := model.StorageAccountId.ValueString(); v != "" {
+		input.NetworkIsolation = &servers.NetworkIsolationSettings{
+			StorageAccountResourceId: pointer.To(v),
+			SqlServerResourceId:      pointer.To(serverId.ID()),
+		}
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("importing %q into %s", input.StorageUri, id),
+	})
+
+	resp, err := client.ImportDatabase(ctx, *serverId, input)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Errorf("importing %s: %w", id, err))
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("import of %s accepted, waiting for the import to complete", id),
+	})
+
+	if err := resp.Poller.PollUntilDone(ctx); err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Errorf("waiting for the import of %s: %w", id, err))
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("imported %q into %s", input.StorageUri, id),
+	})
+}
+
+func (m *MsSqlDatabaseImportAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	m.Defaults(ctx, request, response)
+}
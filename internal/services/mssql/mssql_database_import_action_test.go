@@ -0,0 +1,138 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package mssql_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type MsSqlDatabaseImportAction struct{}
+
+func TestAccMsSqlDatabaseImportAction_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_database_import", "test")
+	a := MsSqlDatabaseImportAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: a.basic(data),
+			},
+		},
+	})
+}
+
+func TestAccMsSqlDatabaseImportAction_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_database_import", "test")
+	a := MsSqlDatabaseImportAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: a.complete(data),
+			},
+		},
+	})
+}
+
+func (r *MsSqlDatabaseImportAction) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+action "azurerm_mssql_database_import" "test" {
+  config {
+    server_id                    = azurerm_mssql_server.test.id
+    database_name                = "acctest-db-import-%d"
+    storage_uri                  = azurerm_storage_blob.test.url
+    storage_key                  = azurerm_storage_account.test.primary_access_key
+    storage_key_type             = "StorageAccessKey"
+    administrator_login          = azurerm_mssql_server.test.administrator_login
+    administrator_login_password = azurerm_mssql_server.test.administrator_login_password
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r *MsSqlDatabaseImportAction) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+action "azurerm_mssql_database_import" "test" {
+  config {
+    server_id                    = azurerm_mssql_server.test.id
+    database_name                = "acctest-db-import-%d"
+    storage_uri                  = azurerm_storage_blob.test.url
+    storage_key                  = azurerm_storage_account.test.primary_access_key
+    storage_key_type             = "StorageAccessKey"
+    administrator_login          = azurerm_mssql_server.test.administrator_login
+    administrator_login_password = azurerm_mssql_server.test.administrator_login_password
+    authentication_type          = "Sql"
+    edition                      = "Standard"
+    sku_name                     = "S1"
+    max_size_gb                  = 10
+    timeout                      = "3h"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r *MsSqlDatabaseImportAction) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_storage_account" "test" {
+  name                     = "accsa%[2]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "bacpac"
+  storage_account_id    = azurerm_storage_account.test.id
+  container_access_type = "private"
+}
+
+resource "azurerm_storage_blob" "test" {
+  name                 = "test.bacpac"
+  storage_container_id = azurerm_storage_container.test.id
+  type                 = "Block"
+  source               = "testdata/sql_import.bacpac"
+}
+
+resource "azurerm_mssql_firewall_rule" "test" {
+  name             = "allowazure"
+  server_id        = azurerm_mssql_server.test.id
+  start_ip_address = "0.0.0.0"
+  end_ip_address   = "0.0.0.0"
+}
+
+resource "terraform_data" "trigger" {
+  input = azurerm_storage_blob.test.id
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_mssql_database_import.test]
+    }
+  }
+
+  depends_on = [azurerm_mssql_firewall_rule.test]
+}
+`, MssqlDatabaseResource{}.template(data), data.RandomString)
+}
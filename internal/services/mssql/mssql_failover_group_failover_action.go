@@ -0,0 +1,205 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package mssql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/failovergroups"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+const (
+	failoverGroupFailoverTypePlanned                = "Planned"
+	failoverGroupFailoverTypeForced                 = "Forced"
+	failoverGroupFailoverTypeTryPlannedBeforeForced = "TryPlannedBeforeForced"
+)
+
+type MsSqlFailoverGroupFailoverAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &MsSqlFailoverGroupFailoverAction{}
+
+func newMsSqlFailoverGroupFailoverAction() action.Action {
+	return &MsSqlFailoverGroupFailoverAction{}
+}
+
+type MsSqlFailoverGroupFailoverActionModel struct {
+	FailoverGroupId types.String `tfsdk:"failover_group_id"`
+	FailoverType    types.String `tfsdk:"failover_type"`
+	Timeout         types.String `tfsdk:"timeout"`
+}
+
+func (m *MsSqlFailoverGroupFailoverAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"failover_group_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the failover group to fail over. The ID may reference the failover group on either the primary or the secondary server.",
+				MarkdownDescription: "The ID of the failover group to fail over. The ID may reference the failover group on either the primary or the secondary server.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: failovergroups.ValidateFailoverGroupID,
+					},
+				},
+			},
+
+			"failover_type": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The type of failover to perform. Possible values are `Planned`, `Forced` and `TryPlannedBeforeForced`. Defaults to `Planned`.",
+				MarkdownDescription: "The type of failover to perform. Possible values are `Planned`, `Forced` and `TryPlannedBeforeForced`. Defaults to `Planned`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						failoverGroupFailoverTypePlanned,
+						failoverGroupFailoverTypeForced,
+						failoverGroupFailoverTypeTryPlannedBeforeForced,
+					),
+				},
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the action to complete. Defaults to `60m`.",
+				MarkdownDescription: "Timeout duration for the action to complete. Defaults to `60m`.",
+			},
+		},
+	}
+}
+
+func (m *MsSqlFailoverGroupFailoverAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_mssql_failover_group_failover"
+}
+
+func (m *MsSqlFailoverGroupFailoverAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := m.Client.MSSQL.FailoverGroupsClient
+
+	model := MsSqlFailoverGroupFailoverActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	timeout := 60 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+		timeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	id, err := failovergroups.ParseFailoverGroupID(model.FailoverGroupId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	// a failover must be requested against the failover group on the secondary server, which becomes the new primary
+	secondaryId, err := m.secondaryFailoverGroupId(ctx, client, *id)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", err)
+		return
+	}
+
+	failoverType := failoverGroupFailoverTypePlanned
+	if v := model.FailoverType.ValueString(); v != "" {
+		failoverType = v
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("performing a %s failover of %s to server %q", failoverType, id, secondaryId.ServerName),
+	})
+
+	switch failoverType {
+	case failoverGroupFailoverTypeForced:
+		err = client.ForceFailoverAllowDataLossThenPoll(ctx, *secondaryId)
+	case failoverGroupFailoverTypeTryPlannedBeforeForced:
+		err = client.TryPlannedBeforeForcedFailoverThenPoll(ctx, *secondaryId)
+	default:
+		err = client.FailoverThenPoll(ctx, *secondaryId)
+	}
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Errorf("failing over %s: %w", secondaryId, err))
+		return
+	}
+
+	existing, err := client.Get(ctx, *secondaryId)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Errorf("retrieving %s: %w", secondaryId, err))
+		return
+	}
+
+	primaryServerName := ""
+	if existing.Model != nil && existing.Model.Properties != nil {
+		if pointer.From(existing.Model.Properties.ReplicationRole) == failovergroups.FailoverGroupReplicationRolePrimary {
+			primaryServerName = secondaryId.ServerName
+		} else {
+			for _, partner := range existing.Model.Properties.PartnerServers {
+				if pointer.From(partner.ReplicationRole) == failovergroups.FailoverGroupReplicationRolePrimary {
+					if partnerId, err := commonids.ParseSqlServerIDInsensitively(partner.Id); err == nil {
+						primaryServerName = partnerId.ServerName
+					}
+				}
+			}
+		}
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("failed over Failover Group %q, the primary server is now %q", id.FailoverGroupName, primaryServerName),
+	})
+}
+
+// secondaryFailoverGroupId returns the ID of the Failover Group on the secondary server, resolving it through the
+// partner servers when the given ID references the Failover Group on the primary server
+func (m *MsSqlFailoverGroupFailoverAction) secondaryFailoverGroupId(ctx context.Context, client *failovergroups.FailoverGroupsClient, id failovergroups.FailoverGroupId) (*failovergroups.FailoverGroupId, error) {
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %w", id, err)
+	}
+
+	if existing.Model == nil || existing.Model.Properties == nil {
+		return nil, fmt.Errorf("retrieving %s: `properties` was nil", id)
+	}
+
+	props := existing.Model.Properties
+	if pointer.From(props.ReplicationRole) != failovergroups.FailoverGroupReplicationRolePrimary {
+		return &id, nil
+	}
+
+	for _, partner := range props.PartnerServers {
+		if pointer.From(partner.ReplicationRole) != failovergroups.FailoverGroupReplicationRoleSecondary {
+			continue
+		}
+
+		partnerId, err := commonids.ParseSqlServerIDInsensitively(partner.Id)
+		if err != nil {
+			return nil, fmt.Errorf("parsing the partner server ID for %s: %w", id, err)
+		}
+
+		secondaryId := failovergroups.NewFailoverGroupID(partnerId.SubscriptionId, partnerId.ResourceGroupName, partnerId.ServerName, id.FailoverGroupName)
+		return &secondaryId, nil
+	}
+
+	return nil, fmt.Errorf("no secondary partner server was found for %s", id)
+}
+
+func (m *MsSqlFailoverGroupFailoverAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	m.Defaults(ctx, request, response)
+}
@@ -0,0 +1,93 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package mssql_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type MsSqlFailoverGroupFailoverAction struct{}
+
+func TestAccMsSqlFailoverGroupFailoverAction_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_failover_group_failover", "test")
+	a := MsSqlFailoverGroupFailoverAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: a.basic(data),
+			},
+		},
+	})
+}
+
+func TestAccMsSqlFailoverGroupFailoverAction_forced(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_failover_group_failover", "test")
+	a := MsSqlFailoverGroupFailoverAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: a.forced(data),
+			},
+		},
+	})
+}
+
+func (r *MsSqlFailoverGroupFailoverAction) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+action "azurerm_mssql_failover_group_failover" "test" {
+  config {
+    failover_group_id = azurerm_mssql_failover_group.test.id
+  }
+}
+`, r.template(data))
+}
+
+func (r *MsSqlFailoverGroupFailoverAction) forced(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+action "azurerm_mssql_failover_group_failover" "test" {
+  config {
+    failover_group_id = azurerm_mssql_failover_group.test.id
+    failover_type     = "Forced"
+    timeout           = "90m"
+  }
+}
+`, r.template(data))
+}
+
+func (r *MsSqlFailoverGroupFailoverAction) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "terraform_data" "trigger" {
+  input = azurerm_mssql_failover_group.test.id
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_mssql_failover_group_failover.test]
+    }
+  }
+}
+`, MsSqlFailoverGroupResource{}.manualFailover(data))
+}
@@ -65,43 +65,26 @@ func (r MssqlServerListResource) List(ctx context.Context, request list.ListRequ
 		results = resp.Items
 	}
 
-	deadline, ok := ctx.Deadline()
-	if !ok {
-		sdk.SetResponseErrorDiagnostic(stream, "internal-error", "context had no deadline")
-		return
-	}
+	stream.Results = sdk.ListResultsConcurrently(ctx, request, metadata, results, func(ctx context.Context, server servers.Server, push func(list.ListResult) bool) {
+		result := request.NewListResult(ctx)
+
+		result.DisplayName = pointer.From(server.Name)
 
-	stream.Results = func(push func(list.ListResult) bool) {
-		ctx, cancel := context.WithDeadline(context.Background(), deadline)
-		defer cancel()
-
-		for _, server := range results {
-			result := request.NewListResult(ctx)
-
-			result.DisplayName = pointer.From(server.Name)
-
-			rd := resourceMsSqlServer().Data(&terraform.InstanceState{})
-
-			id, err := commonids.ParseSqlServerID(pointer.From(server.Id))
-			if err != nil {
-				sdk.SetErrorDiagnosticAndPushListResult(result, push, "parsing Mssql Server ID", err)
-				return
-			}
-			rd.SetId(id.ID())
-
-			if err := resourceMssqlServerSetFlatten(ctx, rd, id, &server, metadata.Client); err != nil {
-				sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("encoding `%s` resource data", `azurerm_mssql_server`), err)
-				return
-			}
-
-			sdk.EncodeListResult(ctx, rd, &result)
-			if result.Diagnostics.HasError() {
-				push(result)
-				return
-			}
-			if !push(result) {
-				return
-			}
+		rd := resourceMsSqlServer().Data(&terraform.InstanceState{})
+
+		id, err := commonids.ParseSqlServerID(pointer.From(server.Id))
+		if err != nil {
+			sdk.SetErrorDiagnosticAndPushListResult(result, push, "parsing Mssql Server ID", err)
+			return
 		}
-	}
+		rd.SetId(id.ID())
+
+		if err := resourceMssqlServerSetFlatten(ctx, rd, id, &server, metadata.Client); err != nil {
+			sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("encoding `%s` resource data", `azurerm_mssql_server`), err)
+			return
+		}
+
+		sdk.EncodeListResult(ctx, rd, &result)
+		push(result)
+	})
 }
@@ -88,6 +88,9 @@ func (r Registration) Resources() []sdk.Resource {
 
 func (r Registration) Actions() []func() action.Action {
 	return []func() action.Action{
+		newMsSqlDatabaseExportAction,
+		newMsSqlDatabaseImportAction,
+		newMsSqlFailoverGroupFailoverAction,
 		newMssqlJobExecuteAction,
 	}
 }
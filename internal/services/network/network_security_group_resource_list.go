@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/networksecuritygroups"
 	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -16,12 +17,20 @@ import (
 
 type NetworkSecurityGroupListResource struct{}
 
-var _ sdk.FrameworkListWrappedResource = new(NetworkSecurityGroupListResource)
+var _ sdk.FrameworkListWrappedResourceWithResourceGraph = new(NetworkSecurityGroupListResource)
 
 func (r NetworkSecurityGroupListResource) ResourceFunc() *pluginsdk.Resource {
 	return resourceNetworkSecurityGroup()
 }
 
+func (r NetworkSecurityGroupListResource) ResourceGraphType() string {
+	return "microsoft.network/networksecuritygroups"
+}
+
+func (r NetworkSecurityGroupListResource) ResourceId() resourceids.ResourceId {
+	return &networksecuritygroups.NetworkSecurityGroupId{}
+}
+
 func (r NetworkSecurityGroupListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
 	response.TypeName = networkSecurityGroupResourceName
 }
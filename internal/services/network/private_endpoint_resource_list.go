@@ -65,44 +65,27 @@ func (r PrivateEndpointListResource) List(ctx context.Context, request list.List
 		results = resp.Items
 	}
 
-	deadline, ok := ctx.Deadline()
-	if !ok {
-		sdk.SetResponseErrorDiagnostic(stream, "internal-error", "context had no deadline")
-		return
-	}
-
-	stream.Results = func(push func(list.ListResult) bool) {
-		ctx, cancel := context.WithDeadline(context.Background(), deadline)
-		defer cancel()
+	stream.Results = sdk.ListResultsConcurrently(ctx, request, metadata, results, func(ctx context.Context, privateendpoint privateendpoints.PrivateEndpoint, push func(list.ListResult) bool) {
+		result := request.NewListResult(ctx)
 
-		for _, privateendpoint := range results {
-			result := request.NewListResult(ctx)
+		result.DisplayName = pointer.From(privateendpoint.Name)
 
-			result.DisplayName = pointer.From(privateendpoint.Name)
+		rd := resourcePrivateEndpoint().Data(&terraform.InstanceState{})
 
-			rd := resourcePrivateEndpoint().Data(&terraform.InstanceState{})
-
-			id, err := privateendpoints.ParsePrivateEndpointID(pointer.From(privateendpoint.Id))
-			if err != nil {
-				sdk.SetErrorDiagnosticAndPushListResult(result, push, "parsing PrivateEndpoint ID", err)
-				return
-			}
-
-			rd.SetId(id.ID())
+		id, err := privateendpoints.ParsePrivateEndpointID(pointer.From(privateendpoint.Id))
+		if err != nil {
+			sdk.SetErrorDiagnosticAndPushListResult(result, push, "parsing PrivateEndpoint ID", err)
+			return
+		}
 
-			if err := resourcePrivateEndpointFlatten(ctx, metaClient, rd, id, &privateendpoint, request.IncludeResource); err != nil {
-				sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("encoding `%s` resource data", "azurerm_private_endpoint"), err)
-				return
-			}
+		rd.SetId(id.ID())
 
-			sdk.EncodeListResult(ctx, rd, &result)
-			if result.Diagnostics.HasError() {
-				push(result)
-				return
-			}
-			if !push(result) {
-				return
-			}
+		if err := resourcePrivateEndpointFlatten(ctx, metaClient, rd, id, &privateendpoint, request.IncludeResource); err != nil {
+			sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("encoding `%s` resource data", "azurerm_private_endpoint"), err)
+			return
 		}
-	}
+
+		sdk.EncodeListResult(ctx, rd, &result)
+		push(result)
+	})
 }
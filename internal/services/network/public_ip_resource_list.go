@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/publicipaddresses"
 	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -19,12 +20,20 @@ import (
 
 type PublicIpListResource struct{}
 
-var _ sdk.FrameworkListWrappedResource = new(PublicIpListResource)
+var _ sdk.FrameworkListWrappedResourceWithResourceGraph = new(PublicIpListResource)
 
 func (r PublicIpListResource) ResourceFunc() *pluginsdk.Resource {
 	return resourcePublicIp()
 }
 
+func (r PublicIpListResource) ResourceGraphType() string {
+	return "microsoft.network/publicipaddresses"
+}
+
+func (r PublicIpListResource) ResourceId() resourceids.ResourceId {
+	return &commonids.PublicIPAddressId{}
+}
+
 func (r PublicIpListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
 	response.TypeName = "azurerm_public_ip"
 }
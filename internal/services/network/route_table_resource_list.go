@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/routetables"
 	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -16,12 +17,20 @@ import (
 
 type RouteTableListResource struct{}
 
-var _ sdk.FrameworkListWrappedResource = new(RouteTableListResource)
+var _ sdk.FrameworkListWrappedResourceWithResourceGraph = new(RouteTableListResource)
 
 func (r RouteTableListResource) ResourceFunc() *pluginsdk.Resource {
 	return resourceRouteTable()
 }
 
+func (r RouteTableListResource) ResourceGraphType() string {
+	return "microsoft.network/routetables"
+}
+
+func (r RouteTableListResource) ResourceId() resourceids.ResourceId {
+	return &routetables.RouteTableId{}
+}
+
 func (r RouteTableListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
 	response.TypeName = routeTableResourceName
 }
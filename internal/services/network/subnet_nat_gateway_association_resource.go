@@ -4,6 +4,7 @@
 package network
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -134,11 +135,11 @@ func resourceSubnetNatGatewayAssociationCreate(d *pluginsdk.ResourceData, meta i
 		return fmt.Errorf("waiting for provisioning state of virtual network for NAT Gateway Association for %s: %+v", *subnetId, err)
 	}
 
-	return resourceSubnetNatGatewayAssociationRead(d, meta)
+	// the locks on the Virtual Network and Subnet are still held, so the association is read without taking the read lock
+	return subnetNatGatewayAssociationRead(ctx, d, meta, *subnetId)
 }
 
 func resourceSubnetNatGatewayAssociationRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.Subnets
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -147,14 +148,28 @@ func resourceSubnetNatGatewayAssociationRead(d *pluginsdk.ResourceData, meta int
 		return err
 	}
 
-	subnet, err := client.Get(ctx, *id, subnets.DefaultGetOperationOptions())
+	// associations within the same Virtual Network can be read concurrently, but not whilst one is being changed
+	vnetId := commonids.NewVirtualNetworkID(id.SubscriptionId, id.ResourceGroupName, id.VirtualNetworkName)
+	if err := locks.ReadByIDWithContext(ctx, vnetId.ID()); err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	defer locks.UnlockReadByID(vnetId.ID())
+
+	return subnetNatGatewayAssociationRead(ctx, d, meta, *id)
+}
+
+// subnetNatGatewayAssociationRead reads the NAT Gateway Association for the Subnet, the caller must hold a lock on the Virtual Network
+func subnetNatGatewayAssociationRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}, id commonids.SubnetId) error {
+	client := meta.(*clients.Client).Network.Subnets
+
+	subnet, err := client.Get(ctx, id, subnets.DefaultGetOperationOptions())
 	if err != nil {
 		if response.WasNotFound(subnet.HttpResponse) {
-			log.Printf("[DEBUG] %s could not be found - removing from state!", *id)
+			log.Printf("[DEBUG] %s could not be found - removing from state!", id)
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("retrieving %s: %+v", *id, err)
+		return fmt.Errorf("retrieving %s: %+v", id, err)
 	}
 
 	if subnet.Model == nil {
@@ -166,7 +181,7 @@ func resourceSubnetNatGatewayAssociationRead(d *pluginsdk.ResourceData, meta int
 
 	props := subnet.Model.Properties
 	if props.NatGateway == nil || props.NatGateway.Id == nil {
-		log.Printf("[DEBUG] %s doesn't have a NAT Gateway - removing from state!", *id)
+		log.Printf("[DEBUG] %s doesn't have a NAT Gateway - removing from state!", id)
 		d.SetId("")
 		return nil
 	}
@@ -4,6 +4,7 @@
 package network
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -175,11 +176,11 @@ func resourceSubnetNetworkSecurityGroupAssociationCreate(d *pluginsdk.ResourceDa
 		return fmt.Errorf("waiting for provisioning state of virtual network for Network Security Group Association for %s: %+v", *subnetId, err)
 	}
 
-	return resourceSubnetNetworkSecurityGroupAssociationRead(d, meta)
+	// the locks on the Virtual Network and Subnet are still held, so the association is read without taking the read lock
+	return subnetNetworkSecurityGroupAssociationRead(ctx, d, meta, *subnetId)
 }
 
 func resourceSubnetNetworkSecurityGroupAssociationRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.Subnets
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -188,29 +189,43 @@ func resourceSubnetNetworkSecurityGroupAssociationRead(d *pluginsdk.ResourceData
 		return err
 	}
 
-	resp, err := client.Get(ctx, *id, subnets.DefaultGetOperationOptions())
+	// associations within the same Virtual Network can be read concurrently, but not whilst one is being changed
+	vnetId := commonids.NewVirtualNetworkID(id.SubscriptionId, id.ResourceGroupName, id.VirtualNetworkName)
+	if err := locks.ReadByIDWithContext(ctx, vnetId.ID()); err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	defer locks.UnlockReadByID(vnetId.ID())
+
+	return subnetNetworkSecurityGroupAssociationRead(ctx, d, meta, *id)
+}
+
+// subnetNetworkSecurityGroupAssociationRead reads the Network Security Group Association for the Subnet, the caller must hold a lock on the Virtual Network
+func subnetNetworkSecurityGroupAssociationRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}, id commonids.SubnetId) error {
+	client := meta.(*clients.Client).Network.Subnets
+
+	resp, err := client.Get(ctx, id, subnets.DefaultGetOperationOptions())
 	if err != nil {
 		if response.WasNotFound(resp.HttpResponse) {
-			log.Printf("[DEBUG] %s could not be found - removing from state!", *id)
+			log.Printf("[DEBUG] %s could not be found - removing from state!", id)
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("retrieving %s: %+v", *id, err)
+		return fmt.Errorf("retrieving %s: %+v", id, err)
 	}
 
 	model := resp.Model
 	if model == nil {
-		return fmt.Errorf("`model` was nil for %s", *id)
+		return fmt.Errorf("`model` was nil for %s", id)
 	}
 
 	props := model.Properties
 	if props == nil {
-		return fmt.Errorf("`properties` was nil for %s", *id)
+		return fmt.Errorf("`properties` was nil for %s", id)
 	}
 
 	securityGroup := props.NetworkSecurityGroup
 	if securityGroup == nil {
-		log.Printf("[DEBUG] %s doesn't have a Network Security Group - removing from state!", *id)
+		log.Printf("[DEBUG] %s doesn't have a Network Security Group - removing from state!", id)
 		d.SetId("")
 		return nil
 	}
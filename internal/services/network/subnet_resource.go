@@ -370,7 +370,9 @@ func resourceSubnetCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 		if err != nil {
 			return err
 		}
-		locks.ByID(nsgId.ID())
+		if err := locks.LockWithContext(ctx, nsgId.ID()); err != nil {
+			return fmt.Errorf("locking %s: %+v", nsgId, err)
+		}
 		defer locks.UnlockByID(nsgId.ID())
 	}
 
@@ -383,15 +385,21 @@ func resourceSubnetCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 		if err != nil {
 			return err
 		}
-		locks.ByID(rtId.ID())
+		if err := locks.LockWithContext(ctx, rtId.ID()); err != nil {
+			return fmt.Errorf("locking %s: %+v", rtId, err)
+		}
 		defer locks.UnlockByID(rtId.ID())
 	}
 
 	vnetId := commonids.NewVirtualNetworkID(id.SubscriptionId, id.ResourceGroupName, id.VirtualNetworkName)
-	locks.ByID(vnetId.ID())
+	if err := locks.LockWithContext(ctx, vnetId.ID()); err != nil {
+		return fmt.Errorf("locking %s: %+v", vnetId, err)
+	}
 	defer locks.UnlockByID(vnetId.ID())
 
-	locks.ByID(id.ID())
+	if err := locks.LockWithContext(ctx, id.ID()); err != nil {
+		return fmt.Errorf("locking %s: %+v", id, err)
+	}
 	defer locks.UnlockByID(id.ID())
 
 	existing, err := client.Get(ctx, id, subnets.DefaultGetOperationOptions())
@@ -529,17 +537,25 @@ func resourceSubnetUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 		}
 	}
 
-	locks.MultipleByID(&nsgIds)
+	if err := locks.MultipleByIDWithContext(ctx, &nsgIds); err != nil {
+		return fmt.Errorf("locking Network Security Groups: %+v", err)
+	}
 	defer locks.UnlockMultipleByID(&nsgIds)
 
-	locks.MultipleByID(&rtIds)
+	if err := locks.MultipleByIDWithContext(ctx, &rtIds); err != nil {
+		return fmt.Errorf("locking Route Tables: %+v", err)
+	}
 	defer locks.UnlockMultipleByID(&rtIds)
 
 	vnetId := commonids.NewVirtualNetworkID(id.SubscriptionId, id.ResourceGroupName, id.VirtualNetworkName)
-	locks.ByID(vnetId.ID())
+	if err := locks.LockWithContext(ctx, vnetId.ID()); err != nil {
+		return fmt.Errorf("locking %s: %+v", vnetId, err)
+	}
 	defer locks.UnlockByID(vnetId.ID())
 
-	locks.ByID(id.ID())
+	if err := locks.LockWithContext(ctx, id.ID()); err != nil {
+		return fmt.Errorf("locking %s: %+v", id, err)
+	}
 	defer locks.UnlockByID(id.ID())
 
 	existing, err := client.Get(ctx, *id, subnets.DefaultGetOperationOptions())
@@ -786,10 +802,14 @@ func resourceSubnetDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	}
 
 	vnetId := commonids.NewVirtualNetworkID(id.SubscriptionId, id.ResourceGroupName, id.VirtualNetworkName)
-	locks.ByID(vnetId.ID())
+	if err := locks.LockWithContext(ctx, vnetId.ID()); err != nil {
+		return fmt.Errorf("locking %s: %+v", vnetId, err)
+	}
 	defer locks.UnlockByID(vnetId.ID())
 
-	locks.ByID(id.ID())
+	if err := locks.LockWithContext(ctx, id.ID()); err != nil {
+		return fmt.Errorf("locking %s: %+v", id, err)
+	}
 	defer locks.UnlockByID(id.ID())
 
 	if err := client.DeleteThenPoll(ctx, *id); err != nil {
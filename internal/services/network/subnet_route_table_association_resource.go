@@ -4,6 +4,7 @@
 package network
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -175,11 +176,11 @@ func resourceSubnetRouteTableAssociationCreate(d *pluginsdk.ResourceData, meta i
 		return fmt.Errorf("waiting for provisioning state of virtual network for Route Table Association for %s: %+v", id, err)
 	}
 
-	return resourceSubnetRouteTableAssociationRead(d, meta)
+	// the locks on the Virtual Network and Subnet are still held, so the association is read without taking the read lock
+	return subnetRouteTableAssociationRead(ctx, d, meta, *id)
 }
 
 func resourceSubnetRouteTableAssociationRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.Subnets
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -188,7 +189,21 @@ func resourceSubnetRouteTableAssociationRead(d *pluginsdk.ResourceData, meta int
 		return err
 	}
 
-	resp, err := client.Get(ctx, *id, subnets.DefaultGetOperationOptions())
+	// associations within the same Virtual Network can be read concurrently, but not whilst one is being changed
+	vnetId := commonids.NewVirtualNetworkID(id.SubscriptionId, id.ResourceGroupName, id.VirtualNetworkName)
+	if err := locks.ReadByIDWithContext(ctx, vnetId.ID()); err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	defer locks.UnlockReadByID(vnetId.ID())
+
+	return subnetRouteTableAssociationRead(ctx, d, meta, *id)
+}
+
+// subnetRouteTableAssociationRead reads the Route Table Association for the Subnet, the caller must hold a lock on the Virtual Network
+func subnetRouteTableAssociationRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}, id commonids.SubnetId) error {
+	client := meta.(*clients.Client).Network.Subnets
+
+	resp, err := client.Get(ctx, id, subnets.DefaultGetOperationOptions())
 	if err != nil {
 		if response.WasNotFound(resp.HttpResponse) {
 			log.Printf("[DEBUG] %s could not be found - removing from state!", id)
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourcegroups"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/terraform-plugin-framework/list"
 	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -27,7 +28,15 @@ func (r VirtualNetworkListResource) ResourceFunc() *pluginsdk.Resource {
 	return resourceVirtualNetwork()
 }
 
-var _ sdk.FrameworkListWrappedResource = &VirtualNetworkListResource{}
+func (r VirtualNetworkListResource) ResourceGraphType() string {
+	return "microsoft.network/virtualnetworks"
+}
+
+func (r VirtualNetworkListResource) ResourceId() resourceids.ResourceId {
+	return &commonids.VirtualNetworkId{}
+}
+
+var _ sdk.FrameworkListWrappedResourceWithResourceGraph = &VirtualNetworkListResource{}
 
 type VirtualNetworkListModel struct {
 	ResourceGroupName types.String `tfsdk:"resource_group_name"`
@@ -40,6 +40,38 @@ func TestAccVirtualNetwork_list_basic(t *testing.T) {
 	})
 }
 
+func TestAccVirtualNetwork_list_resourceGraph(t *testing.T) {
+	r := VirtualNetworkResource{}
+
+	data := acceptance.BuildTestData(t, "azurerm_virtual_network", "test")
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: r.basicList(data),
+			},
+			{
+				Query:  true,
+				Config: r.subscriptionList_query(),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLengthAtLeast("azurerm_virtual_network.test", 3),
+				},
+			},
+			{
+				Query:  true,
+				Config: r.filterList_query(data),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("azurerm_virtual_network.test", 3),
+				},
+			},
+		},
+	})
+}
+
 func (r VirtualNetworkResource) basicList(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -81,3 +113,30 @@ list "azurerm_virtual_network" "test" {
 }
 `, data.RandomInteger)
 }
+
+func (r VirtualNetworkResource) subscriptionList_query() string {
+	return `
+list "azurerm_virtual_network" "test" {
+  provider = azurerm
+
+  config {}
+}
+`
+}
+
+func (r VirtualNetworkResource) filterList_query(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+list "azurerm_virtual_network" "test" {
+  provider = azurerm
+
+  config {
+    filter          = "tags.environment == 'Production' and name endswith '-%d'"
+    max_concurrency = 2
+
+    timeouts {
+      list = "30m"
+    }
+  }
+}
+`, data.RandomInteger)
+}
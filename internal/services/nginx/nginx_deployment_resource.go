@@ -14,7 +14,6 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/nginx/2024-11-01-preview/nginxdeployment"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/preflight"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -122,6 +121,8 @@ type DeploymentResource struct{}
 
 var _ sdk.ResourceWithUpdate = (*DeploymentResource)(nil)
 
+var _ sdk.ResourceWithPreflight = (*DeploymentResource)(nil)
+
 func (m DeploymentResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"resource_group_name": commonschema.ResourceGroupName(),
@@ -709,40 +710,24 @@ func (m DeploymentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return nginxdeployment.ValidateNginxDeploymentID
 }
 
-func (m DeploymentResource) CustomizeDiff() sdk.ResourceFunc {
-	return sdk.ResourceFunc{
-		Timeout: 5 * time.Minute,
-		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
-			rd := metadata.ResourceDiff
-
-			if metadata.Client.Features.EnhancedValidation.PreflightEnabled {
-				// Only perform preflight validation if there are changes. This avoids validation failures and
-				// additional API calls for resources that are unchanged between plan invocations
-				if len(rd.GetChangedKeysPrefix("")) > 0 || rd.Id() == "" {
-					var model DeploymentModel
-					if err := metadata.DecodeDiff(&model); err != nil {
-						return err
-					}
-
-					req, err := expandCreateForNginxDeployment(model)
-					if err != nil {
-						return err
-					}
-
-					id := nginxdeployment.NewNginxDeploymentID(metadata.Client.Account.SubscriptionId, model.ResourceGroupName, model.Name)
-
-					preflightValidate, err := preflight.NewValidationRequest(pointer.To(model.Location), pointer.To(id), "2024-11-01-preview", req)
-					if err != nil {
-						return fmt.Errorf("constructing preflight validation request: %w", err)
-					}
+func (m DeploymentResource) PreflightPayload() sdk.PreflightPayloadFunc {
+	return func(ctx context.Context, metadata sdk.ResourceMetaData) (*sdk.PreflightPayload, error) {
+		var model DeploymentModel
+		if err := metadata.DecodeDiff(&model); err != nil {
+			return nil, err
+		}
 
-					if err = preflightValidate.ValidateResource(ctx, metadata); err != nil {
-						return err
-					}
-				}
-			}
+		req, err := expandCreateForNginxDeployment(model)
+		if err != nil {
+			return nil, err
+		}
 
-			return nil
-		},
+		id := nginxdeployment.NewNginxDeploymentID(metadata.Client.Account.SubscriptionId, model.ResourceGroupName, model.Name)
+		return &sdk.PreflightPayload{
+			Location:   pointer.To(model.Location),
+			ResourceId: pointer.To(id),
+			ApiVersion: "2024-11-01-preview",
+			Properties: req,
+		}, nil
 	}
 }
@@ -62,42 +62,25 @@ func (r PrivateDnsZoneListResource) List(ctx context.Context, request list.ListR
 		results = resp.Items
 	}
 
-	deadline, ok := ctx.Deadline()
-	if !ok {
-		sdk.SetResponseErrorDiagnostic(stream, "internal-error", "context had no deadline")
-	}
+	stream.Results = sdk.ListResultsConcurrently(ctx, request, metadata, results, func(ctx context.Context, privateZone privatezones.PrivateZone, push func(list.ListResult) bool) {
+		result := request.NewListResult(ctx)
+		result.DisplayName = pointer.From(privateZone.Name)
 
-	stream.Results = func(push func(list.ListResult) bool) {
-		ctx, cancel := context.WithDeadline(context.Background(), deadline)
-		defer cancel()
-
-		for _, privateZone := range results {
-			result := request.NewListResult(ctx)
-			result.DisplayName = pointer.From(privateZone.Name)
-
-			id, err := privatezones.ParsePrivateDnsZoneID(pointer.From(privateZone.Id))
-			if err != nil {
-				sdk.SetErrorDiagnosticAndPushListResult(result, push, "parsing Private DNS Zone ID", err)
-				return
-			}
-
-			rd := resourcePrivateDnsZone().Data(&terraform.InstanceState{})
-			rd.SetId(id.ID())
-
-			if err := resourcePrivateDnsZoneFlatten(ctx, rd, metadata.Client, id, &privateZone, request.IncludeResource); err != nil {
-				sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("encoding `%s` resource data", privateDnsZoneResourceName), err)
-				return
-			}
-
-			sdk.EncodeListResult(ctx, rd, &result)
-			if result.Diagnostics.HasError() {
-				push(result)
-				return
-			}
-
-			if !push(result) {
-				return
-			}
+		id, err := privatezones.ParsePrivateDnsZoneID(pointer.From(privateZone.Id))
+		if err != nil {
+			sdk.SetErrorDiagnosticAndPushListResult(result, push, "parsing Private DNS Zone ID", err)
+			return
 		}
-	}
+
+		rd := resourcePrivateDnsZone().Data(&terraform.InstanceState{})
+		rd.SetId(id.ID())
+
+		if err := resourcePrivateDnsZoneFlatten(ctx, rd, metadata.Client, id, &privateZone, request.IncludeResource); err != nil {
+			sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("encoding `%s` resource data", privateDnsZoneResourceName), err)
+			return
+		}
+
+		sdk.EncodeListResult(ctx, rd, &result)
+		push(result)
+	})
 }
@@ -81,58 +81,40 @@ func (r BackupProtectionPolicyVMListResource) List(ctx context.Context, request
 		return
 	}
 
-	deadline, ok := ctx.Deadline()
-	if !ok {
-		sdk.SetResponseErrorDiagnostic(stream, "internal-error", fmt.Errorf("context had no deadline"))
-		return
-	}
+	stream.Results = sdk.ListResultsConcurrently(ctx, request, metadata, resp.Items, func(ctx context.Context, policy backuppolicies.ProtectionPolicyResource, push func(list.ListResult) bool) {
+		// skip any policies that are not Azure VM backup policies
+		if _, ok := policy.Properties.(backuppolicies.AzureIaaSVMProtectionPolicy); !ok {
+			return
+		}
 
-	stream.Results = func(push func(list.ListResult) bool) {
-		ctx, cancel := context.WithDeadline(context.Background(), deadline)
-		defer cancel()
+		result := request.NewListResult(ctx)
+		result.DisplayName = pointer.From(policy.Name)
 
-		for _, policy := range resp.Items {
-			// skip any policies that are not Azure VM backup policies
-			if _, ok := policy.Properties.(backuppolicies.AzureIaaSVMProtectionPolicy); !ok {
-				continue
-			}
+		id, err := protectionpolicies.ParseBackupPolicyID(pointer.From(policy.Id))
+		if err != nil {
+			sdk.SetErrorDiagnosticAndPushListResult(result, push, "parsing Backup Policy ID", err)
+			return
+		}
 
-			result := request.NewListResult(ctx)
-			result.DisplayName = pointer.From(policy.Name)
+		rd := resourceBackupProtectionPolicyVM().Data(&terraform.InstanceState{})
+		rd.SetId(id.ID())
 
-			id, err := protectionpolicies.ParseBackupPolicyID(pointer.From(policy.Id))
+		var model *protectionpolicies.ProtectionPolicyResource
+		if request.IncludeResource {
+			read, err := protectionPoliciesClient.Get(ctx, *id)
 			if err != nil {
-				sdk.SetErrorDiagnosticAndPushListResult(result, push, "parsing Backup Policy ID", err)
-				return
-			}
-
-			rd := resourceBackupProtectionPolicyVM().Data(&terraform.InstanceState{})
-			rd.SetId(id.ID())
-
-			var model *protectionpolicies.ProtectionPolicyResource
-			if request.IncludeResource {
-				read, err := protectionPoliciesClient.Get(ctx, *id)
-				if err != nil {
-					sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("retrieving %s", id), err)
-					return
-				}
-				model = read.Model
-			}
-
-			if err := resourceBackupProtectionPolicyVMFlatten(rd, id, model); err != nil {
-				sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("encoding `%s` resource data", "azurerm_backup_policy_vm"), err)
-				return
-			}
-
-			sdk.EncodeListResult(ctx, rd, &result)
-			if result.Diagnostics.HasError() {
-				push(result)
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("retrieving %s", id), err)
 				return
 			}
+			model = read.Model
+		}
 
-			if !push(result) {
-				return
-			}
+		if err := resourceBackupProtectionPolicyVMFlatten(rd, id, model); err != nil {
+			sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("encoding `%s` resource data", "azurerm_backup_policy_vm"), err)
+			return
 		}
-	}
+
+		sdk.EncodeListResult(ctx, rd, &result)
+		push(result)
+	})
 }
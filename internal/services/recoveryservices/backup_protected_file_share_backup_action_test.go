@@ -0,0 +1,64 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package recoveryservices_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type BackupProtectedFileShareBackupAction struct{}
+
+func TestAccBackupProtectedFileShareBackupAction_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_backup_protected_file_share_backup", "test")
+	a := BackupProtectedFileShareBackupAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: BackupProtectedFileShareResource{}.basic(data),
+			},
+			{
+				Config: a.basic(data),
+			},
+			{
+				// vault cannot be deleted unless we unregister all backups
+				Config: BackupProtectedFileShareResource{}.base(data),
+			},
+		},
+	})
+}
+
+func (BackupProtectedFileShareBackupAction) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "terraform_data" "trigger" {
+  input = azurerm_backup_protected_file_share.test.id
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_backup_protected_file_share_backup.test]
+    }
+  }
+}
+
+action "azurerm_backup_protected_file_share_backup" "test" {
+  config {
+    backup_protected_file_share_id = azurerm_backup_protected_file_share.test.id
+    retention_days                 = 7
+  }
+}
+`, BackupProtectedFileShareResource{}.basic(data))
+}
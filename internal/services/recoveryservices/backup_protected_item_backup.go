@@ -0,0 +1,170 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package recoveryservices
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2021-12-01/backup" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2023-02-01/backups"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2023-02-01/jobdetails"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2023-02-01/protecteditems"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/recoveryservices/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// triggerProtectedItemBackup triggers an on-demand backup of the Protected Item and waits for the Backup Job to be
+// created, returning the ID of the Backup Job
+func triggerProtectedItemBackup(ctx context.Context, client *client.Client, id protecteditems.ProtectedItemId, request backups.BackupRequest) (*jobdetails.BackupJobId, error) {
+	backupId := backups.NewProtectedItemID(id.SubscriptionId, id.ResourceGroupName, id.VaultName, id.BackupFabricName, id.ProtectionContainerName, id.ProtectedItemName)
+	resp, err := client.BackupsClient.Trigger(ctx, backupId, backups.BackupRequestResource{
+		Properties: request,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("triggering a backup of %s: %+v", id, err)
+	}
+
+	locationURL, err := resp.HttpResponse.Location() // Operation ID found in the Location header
+	if locationURL == nil || err != nil {
+		return nil, fmt.Errorf("unable to determine operation URL for the backup of %s: Location header missing or empty", id)
+	}
+
+	parsedLocation, err := azure.ParseAzureResourceID(handleAzureSdkForGoBug2824(locationURL.Path))
+	if err != nil {
+		return nil, err
+	}
+
+	operationID := parsedLocation.Path["operationResults"]
+	if operationID == "" {
+		return nil, fmt.Errorf("unable to determine the operation ID for the backup of %s from %q", id, locationURL.Path)
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return nil, fmt.Errorf("internal-error: context had no deadline")
+	}
+
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:    []string{string(backup.OperationStatusValuesInProgress)},
+		Target:     []string{string(backup.OperationStatusValuesSucceeded)},
+		Refresh:    backupProtectedItemBackupOperationRefreshFunc(ctx, client.BackupOperationStatusesClient, id, operationID),
+		MinTimeout: 10 * time.Second,
+		Timeout:    time.Until(deadline),
+	}
+
+	result, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("waiting for the backup of %s to start: %+v", id, err)
+	}
+
+	operation := result.(backup.OperationStatus)
+	if operation.Properties != nil {
+		if info, ok := operation.Properties.AsOperationStatusJobExtendedInfo(); ok && info.JobID != nil {
+			jobId := jobdetails.NewBackupJobID(id.SubscriptionId, id.ResourceGroupName, id.VaultName, *info.JobID)
+			return &jobId, nil
+		}
+	}
+
+	return nil, fmt.Errorf("the backup of %s was started but the ID of the Backup Job was not returned", id)
+}
+
+func backupProtectedItemBackupOperationRefreshFunc(ctx context.Context, client *backup.OperationStatusesClient, id protecteditems.ProtectedItemId, operationID string) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(ctx, id.VaultName, id.ResourceGroupName, operationID)
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving the status of operation %q for %s: %+v", operationID, id, err)
+		}
+
+		if resp.Status == backup.OperationStatusValuesFailed || resp.Status == backup.OperationStatusValuesCanceled {
+			message := "no upstream error message"
+			if resp.Error != nil && resp.Error.Message != nil {
+				message = *resp.Error.Message
+			}
+			return resp, string(resp.Status), fmt.Errorf("operation %q for %s completed with the status %q: %s", operationID, id, resp.Status, message)
+		}
+
+		return resp, string(resp.Status), nil
+	}
+}
+
+// waitForBackupJob waits for the Backup Job to complete, calling progress each time the status of the Job changes
+func waitForBackupJob(ctx context.Context, client *jobdetails.JobDetailsClient, id jobdetails.BackupJobId, progress func(status string)) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context had no deadline")
+	}
+
+	lastStatus := ""
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{"InProgress", "Cancelling"},
+		Target:  []string{"Completed", "CompletedWithWarnings", "Failed", "Cancelled"},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.Get(ctx, id)
+			if err != nil {
+				return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			if resp.Model == nil || resp.Model.Properties == nil {
+				return nil, "", fmt.Errorf("retrieving %s: `properties` was nil", id)
+			}
+
+			status := pointer.From(resp.Model.Properties.Job().Status)
+			if status != lastStatus {
+				progress(status)
+				lastStatus = status
+			}
+
+			return *resp.Model, status, nil
+		},
+		MinTimeout:   30 * time.Second,
+		PollInterval: 30 * time.Second,
+		Timeout:      time.Until(deadline),
+	}
+
+	result, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("waiting for %s to complete: %+v", id, err)
+	}
+
+	job := result.(jobdetails.JobResource)
+	if status := pointer.From(job.Properties.Job().Status); status == "Failed" || status == "Cancelled" {
+		if errorDetails := flattenBackupJobErrorDetails(job.Properties); errorDetails != "" {
+			return fmt.Errorf("%s completed with the status %q: %s", id, status, errorDetails)
+		}
+		return fmt.Errorf("%s completed with the status %q", id, status)
+	}
+
+	return nil
+}
+
+func flattenBackupJobErrorDetails(input jobdetails.Job) string {
+	errorDetails := make([]string, 0)
+	switch job := input.(type) {
+	case jobdetails.AzureIaaSVMJob:
+		if job.ErrorDetails != nil {
+			for _, v := range *job.ErrorDetails {
+				errorDetails = append(errorDetails, pointer.From(v.ErrorString))
+			}
+		}
+	case jobdetails.AzureIaaSVMJobV2:
+		if job.ErrorDetails != nil {
+			for _, v := range *job.ErrorDetails {
+				errorDetails = append(errorDetails, pointer.From(v.ErrorString))
+			}
+		}
+	case jobdetails.AzureStorageJob:
+		if job.ErrorDetails != nil {
+			for _, v := range *job.ErrorDetails {
+				errorDetails = append(errorDetails, pointer.From(v.ErrorString))
+			}
+		}
+	}
+
+	return strings.Join(errorDetails, "; ")
+}
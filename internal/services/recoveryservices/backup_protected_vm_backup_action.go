@@ -0,0 +1,156 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package recoveryservices
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2023-02-01/backups"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2023-02-01/protecteditems"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type BackupProtectedVMBackupAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &BackupProtectedVMBackupAction{}
+
+func newBackupProtectedVMBackupAction() action.Action {
+	return &BackupProtectedVMBackupAction{}
+}
+
+type BackupProtectedVMBackupActionModel struct {
+	BackupProtectedVMId types.String `tfsdk:"backup_protected_vm_id"`
+	RetentionDays       types.Int64  `tfsdk:"retention_days"`
+	WaitForCompletion   types.Bool   `tfsdk:"wait_for_completion"`
+	Timeout             types.String `tfsdk:"timeout"`
+}
+
+func (b *BackupProtectedVMBackupAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"backup_protected_vm_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the Backup Protected Virtual Machine to back up.",
+				MarkdownDescription: "The ID of the Backup Protected Virtual Machine to back up.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: protecteditems.ValidateProtectedItemID,
+					},
+				},
+			},
+
+			"retention_days": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "The number of days for which the Recovery Point created by this backup should be retained. Defaults to `30`.",
+				MarkdownDescription: "The number of days for which the Recovery Point created by this backup should be retained. Defaults to `30`.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 36135),
+				},
+			},
+
+			"wait_for_completion": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Should the action wait for the Backup Job to complete, and fail if the Backup Job does not succeed? Defaults to `true`.",
+				MarkdownDescription: "Should the action wait for the Backup Job to complete, and fail if the Backup Job does not succeed? Defaults to `true`.",
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the action to complete. Defaults to `4h`.",
+				MarkdownDescription: "Timeout duration for the action to complete. Defaults to `4h`.",
+			},
+		},
+	}
+}
+
+func (b *BackupProtectedVMBackupAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_backup_protected_vm_backup"
+}
+
+func (b *BackupProtectedVMBackupAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := b.Client.RecoveryServices
+
+	model := BackupProtectedVMBackupActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctxTimeout := 4 * time.Hour
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+
+		ctxTimeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	id, err := protecteditems.ParseProtectedItemID(model.BackupProtectedVMId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	retentionDays := int64(30)
+	if !model.RetentionDays.IsNull() {
+		retentionDays = model.RetentionDays.ValueInt64()
+	}
+	expiry := time.Now().UTC().Add(time.Duration(retentionDays) * 24 * time.Hour)
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("triggering a backup of %s, retained until %s", id, expiry.Format(time.RFC3339)),
+	})
+
+	jobId, err := triggerProtectedItemBackup(ctx, client, *id, backups.IaasVMBackupRequest{
+		RecoveryPointExpiryTimeInUTC: pointer.To(expiry.Format(time.RFC3339)),
+	})
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", err)
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("started %s for %s", jobId, id),
+	})
+
+	if !model.WaitForCompletion.IsNull() && !model.WaitForCompletion.ValueBool() {
+		return
+	}
+
+	progress := func(status string) {
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("backup job %q for %s is %s", jobId.BackupJobName, id, status),
+		})
+	}
+
+	if err := waitForBackupJob(ctx, client.BackupJobDetailsClient, *jobId, progress); err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", err)
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("backup of %s completed", id),
+	})
+}
+
+func (b *BackupProtectedVMBackupAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	b.Defaults(ctx, request, response)
+}
@@ -12,6 +12,8 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservices/2025-08-01/vaults"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2023-02-01/backupprotectableitems"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2023-02-01/backupprotecteditems"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2023-02-01/backups"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2023-02-01/jobdetails"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2023-02-01/protecteditems"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2023-02-01/protectioncontainers"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2023-02-01/resourceguardproxy"
@@ -43,6 +45,8 @@ type Client struct {
 	BackupProtectionContainersClient          *protectioncontainers.ProtectionContainersClient
 	BackupOperationStatusesClient             *backup.OperationStatusesClient
 	BackupOperationResultsClient              *backup.OperationResultsClient
+	BackupsClient                             *backups.BackupsClient
+	BackupJobDetailsClient                    *jobdetails.JobDetailsClient
 	VaultsClient                              *vaults.VaultsClient
 	VaultCertificatesClient                   *azuresdkhacks.VaultCertificatesClient
 	VaultReplicationProvider                  *replicationrecoveryservicesproviders.ReplicationRecoveryServicesProvidersClient
@@ -103,6 +107,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	backupOperationResultClient := backup.NewOperationResultsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&backupOperationResultClient.Client, o.ResourceManagerAuthorizer)
 
+	backupsClient := backups.NewBackupsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&backupsClient.Client, o.ResourceManagerAuthorizer)
+
+	backupJobDetailsClient := jobdetails.NewJobDetailsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&backupJobDetailsClient.Client, o.ResourceManagerAuthorizer)
+
 	backupProtectionContainerOperationResultsClient := backup.NewProtectionContainerOperationResultsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&backupProtectionContainerOperationResultsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -180,6 +190,8 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		ProtectedItemOperationResultsClient:       &protectedItemOperationResultClient,
 		BackupOperationStatusesClient:             &backupOperationStatusesClient,
 		BackupOperationResultsClient:              &backupOperationResultClient,
+		BackupsClient:                             &backupsClient,
+		BackupJobDetailsClient:                    &backupJobDetailsClient,
 		VaultsClient:                              vaultsClient,
 		VaultCertificatesClient:                   &vaultCertificatesClient,
 		VaultsSettingsClient:                      vaultSettingsClient,
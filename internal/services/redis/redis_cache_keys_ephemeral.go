@@ -0,0 +1,134 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/redis/2024-11-01/redisresources"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+var _ sdk.EphemeralResource = &RedisCacheKeysEphemeralResource{}
+
+func NewRedisCacheKeysEphemeralResource() ephemeral.EphemeralResource {
+	return &RedisCacheKeysEphemeralResource{}
+}
+
+type RedisCacheKeysEphemeralResource struct {
+	sdk.EphemeralResourceMetadata
+}
+
+type RedisCacheKeysEphemeralResourceModel struct {
+	RedisCacheId              types.String `tfsdk:"redis_cache_id"`
+	PrimaryAccessKey          types.String `tfsdk:"primary_access_key"`
+	SecondaryAccessKey        types.String `tfsdk:"secondary_access_key"`
+	PrimaryConnectionString   types.String `tfsdk:"primary_connection_string"`
+	SecondaryConnectionString types.String `tfsdk:"secondary_connection_string"`
+}
+
+func (e *RedisCacheKeysEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "azurerm_redis_cache_keys"
+}
+
+func (e *RedisCacheKeysEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	e.Defaults(req, resp)
+}
+
+func (e *RedisCacheKeysEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"redis_cache_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: redisresources.ValidateRediID,
+					},
+				},
+			},
+
+			"primary_access_key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_access_key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"primary_connection_string": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_connection_string": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (e *RedisCacheKeysEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	client := e.Client.Redis.RedisResourcesClient
+	ctx, cancel := context.WithTimeout(ctx, time.Minute*5)
+	defer cancel()
+
+	var data RedisCacheKeysEphemeralResourceModel
+
+	if ok := e.DecodeOpen(ctx, req, resp, &data); !ok {
+		return
+	}
+
+	id, err := redisresources.ParseRediID(data.RedisCacheId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, "", err)
+		return
+	}
+
+	existing, err := client.RedisGet(ctx, *id)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("retrieving %s", id), err)
+		return
+	}
+
+	if existing.Model == nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("retrieving %s", id), "`model` was nil")
+		return
+	}
+	props := existing.Model.Properties
+
+	keys, err := client.RedisListKeys(ctx, *id)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("listing keys for %s", id), err)
+		return
+	}
+
+	if keys.Model == nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("listing keys for %s", id), "`model` was nil")
+		return
+	}
+
+	primaryKey := pointer.From(keys.Model.PrimaryKey)
+	secondaryKey := pointer.From(keys.Model.SecondaryKey)
+	hostName := pointer.From(props.HostName)
+	sslPort := pointer.From(props.SslPort)
+	enableSslPort := !pointer.From(props.EnableNonSslPort)
+
+	data.PrimaryAccessKey = types.StringValue(primaryKey)
+	data.SecondaryAccessKey = types.StringValue(secondaryKey)
+	data.PrimaryConnectionString = types.StringValue(getRedisConnectionString(hostName, sslPort, primaryKey, enableSslPort))
+	data.SecondaryConnectionString = types.StringValue(getRedisConnectionString(hostName, sslPort, secondaryKey, enableSslPort))
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,174 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/redis/2024-11-01/redisresources"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type RedisCacheRebootAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &RedisCacheRebootAction{}
+
+func newRedisCacheRebootAction() action.Action {
+	return &RedisCacheRebootAction{}
+}
+
+type RedisCacheRebootActionModel struct {
+	RedisCacheId types.String `tfsdk:"redis_cache_id"`
+	RebootType   types.String `tfsdk:"reboot_type"`
+	ShardId      types.Int64  `tfsdk:"shard_id"`
+	Ports        types.List   `tfsdk:"ports"`
+	Timeout      types.String `tfsdk:"timeout"`
+}
+
+func (r *RedisCacheRebootAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"redis_cache_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the Redis Cache to reboot.",
+				MarkdownDescription: "The ID of the Redis Cache to reboot.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: redisresources.ValidateRediID,
+					},
+				},
+			},
+
+			"reboot_type": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Which Redis nodes should be rebooted. Possible values are `AllNodes`, `PrimaryNode` and `SecondaryNode`. Defaults to `AllNodes`.",
+				MarkdownDescription: "Which Redis nodes should be rebooted. Possible values are `AllNodes`, `PrimaryNode` and `SecondaryNode`. Defaults to `AllNodes`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(redisresources.PossibleValuesForRebootType()...),
+				},
+			},
+
+			"shard_id": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "The ID of the shard to reboot. Only applicable to Premium Redis Caches with clustering enabled. Defaults to all shards.",
+				MarkdownDescription: "The ID of the shard to reboot. Only applicable to Premium Redis Caches with clustering enabled. Defaults to all shards.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+
+			"ports": schema.ListAttribute{
+				ElementType:         types.Int64Type,
+				Optional:            true,
+				Description:         "A list of ports of the Redis instances to reboot. Only applicable to Redis Caches with more than one replica. Conflicts with `reboot_type`.",
+				MarkdownDescription: "A list of ports of the Redis instances to reboot. Only applicable to Redis Caches with more than one replica. Conflicts with `reboot_type`.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueInt64sAre(int64validator.Between(13000, 15999)),
+					listvalidator.ConflictsWith(path.MatchRoot("reboot_type")),
+				},
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the action to complete. Defaults to `30m`.",
+				MarkdownDescription: "Timeout duration for the action to complete. Defaults to `30m`.",
+			},
+		},
+	}
+}
+
+func (r *RedisCacheRebootAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_redis_cache_reboot"
+}
+
+func (r *RedisCacheRebootAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := r.Client.Redis.RedisResourcesClient
+
+	model := RedisCacheRebootActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctxTimeout := 30 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+
+		ctxTimeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	id, err := redisresources.ParseRediID(model.RedisCacheId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	input := redisresources.RedisRebootParameters{}
+	var target string
+
+	if !model.Ports.IsNull() {
+		ports := make([]int64, 0)
+		response.Diagnostics.Append(model.Ports.ElementsAs(ctx, &ports, false)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		input.Ports = pointer.To(ports)
+		target = fmt.Sprintf("the nodes on ports %s", strings.Trim(fmt.Sprint(ports), "[]"))
+	} else {
+		rebootType := redisresources.RebootTypeAllNodes
+		if v := model.RebootType.ValueString(); v != "" {
+			rebootType = redisresources.RebootType(v)
+		}
+		input.RebootType = pointer.To(rebootType)
+		target = string(rebootType)
+	}
+
+	if !model.ShardId.IsNull() {
+		input.ShardId = pointer.To(model.ShardId.ValueInt64())
+		target = fmt.Sprintf("%s of shard %d", target, model.ShardId.ValueInt64())
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("requesting a reboot of %s of %s", target, id),
+	})
+
+	if _, err := client.RedisForceReboot(ctx, *id, input); err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("rebooting %s: %+v", id, err))
+		return
+	}
+
+	// NOTE: the reboot happens asynchronously and isn't reflected in the `provisioningState` of the Redis Cache, or any
+	// other property which can be polled - so this only confirms that the reboot has been requested
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("requested a reboot of %s of %s, the nodes may take several minutes to become available", target, id),
+	})
+}
+
+func (r *RedisCacheRebootAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	r.Defaults(ctx, request, response)
+}
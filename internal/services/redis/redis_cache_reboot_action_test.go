@@ -0,0 +1,105 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package redis_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type RedisCacheRebootAction struct{}
+
+func TestAccRedisCacheRebootAction_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_redis_cache_reboot", "test")
+	a := RedisCacheRebootAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: RedisCacheResource{}.standard(data),
+			},
+			{
+				Config: a.basic(data),
+			},
+		},
+	})
+}
+
+func TestAccRedisCacheRebootAction_shard(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_redis_cache_reboot", "test")
+	a := RedisCacheRebootAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: RedisCacheResource{}.premiumSharded(data),
+			},
+			{
+				Config: a.shard(data),
+			},
+		},
+	})
+}
+
+func (a RedisCacheRebootAction) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+action "azurerm_redis_cache_reboot" "test" {
+  config {
+    redis_cache_id = azurerm_redis_cache.test.id
+    reboot_type    = "PrimaryNode"
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = azurerm_redis_cache.test.id
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_redis_cache_reboot.test]
+    }
+  }
+}
+`, RedisCacheResource{}.standard(data))
+}
+
+func (a RedisCacheRebootAction) shard(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+action "azurerm_redis_cache_reboot" "test" {
+  config {
+    redis_cache_id = azurerm_redis_cache.test.id
+    reboot_type    = "AllNodes"
+    shard_id       = 1
+    timeout        = "45m"
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = azurerm_redis_cache.test.id
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_redis_cache_reboot.test]
+    }
+  }
+}
+`, RedisCacheResource{}.premiumSharded(data))
+}
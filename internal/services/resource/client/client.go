@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	azureResources "github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources" // nolint: staticcheck
+	graphResources "github.com/hashicorp/go-azure-sdk/resource-manager/resourcegraph/2024-04-01/resources"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2020-05-01/managementlocks"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2020-05-01/privatelinkassociation"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2020-05-01/resourcemanagementprivatelink"
@@ -27,6 +28,7 @@ type Client struct {
 	FeaturesClient                      *features.FeaturesClient
 	LocksClient                         *managementlocks.ManagementLocksClient
 	PrivateLinkAssociationClient        *privatelinkassociation.PrivateLinkAssociationClient
+	ResourceGraphClient                 *graphResources.ResourcesClient
 	ResourcesClient                     *resources.ResourcesClient
 	ResourceGroupsClient                *resourcegroups.ResourceGroupsClient
 	ResourceManagementPrivateLinkClient *resourcemanagementprivatelink.ResourceManagementPrivateLinkClient
@@ -81,6 +83,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(privateLinkAssociationClient.Client, o.Authorizers.ResourceManager)
 
+	resourceGraphClient, err := graphResources.NewResourcesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Resource Graph client: %+v", err)
+	}
+	o.Configure(resourceGraphClient.Client, o.Authorizers.ResourceManager)
+
 	resourcesClient, err := resources.NewResourcesClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building Resource client: %+v", err)
@@ -128,6 +136,7 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		FeaturesClient:                      featuresClient,
 		LocksClient:                         locksClient,
 		PrivateLinkAssociationClient:        privateLinkAssociationClient,
+		ResourceGraphClient:                 resourceGraphClient,
 		ResourcesClient:                     resourcesClient,
 		ResourceManagementPrivateLinkClient: resourceManagementPrivateLinkClient,
 		ResourceGroupsClient:                resourceGroupsClient,
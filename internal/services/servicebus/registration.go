@@ -89,7 +89,11 @@ func (r Registration) FrameworkDataSources() []sdk.FrameworkWrappedDataSource {
 }
 
 func (r Registration) EphemeralResources() []func() ephemeral.EphemeralResource {
-	return []func() ephemeral.EphemeralResource{}
+	return []func() ephemeral.EphemeralResource{
+		NewServiceBusNamespaceAuthorizationRuleEphemeralResource,
+		NewServiceBusQueueAuthorizationRuleEphemeralResource,
+		NewServiceBusTopicAuthorizationRuleEphemeralResource,
+	}
 }
 
 func (r Registration) ListResources() []sdk.FrameworkListWrappedResource {
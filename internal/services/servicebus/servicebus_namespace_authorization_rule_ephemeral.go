@@ -0,0 +1,145 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package servicebus
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2024-01-01/namespacesauthorizationrule"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicebus/validate"
+)
+
+var _ sdk.EphemeralResource = &ServiceBusNamespaceAuthorizationRuleEphemeralResource{}
+
+func NewServiceBusNamespaceAuthorizationRuleEphemeralResource() ephemeral.EphemeralResource {
+	return &ServiceBusNamespaceAuthorizationRuleEphemeralResource{}
+}
+
+type ServiceBusNamespaceAuthorizationRuleEphemeralResource struct {
+	sdk.EphemeralResourceMetadata
+}
+
+type ServiceBusNamespaceAuthorizationRuleEphemeralResourceModel struct {
+	Name                           types.String `tfsdk:"name"`
+	NamespaceID                    types.String `tfsdk:"namespace_id"`
+	PrimaryKey                     types.String `tfsdk:"primary_key"`
+	PrimaryConnectionString        types.String `tfsdk:"primary_connection_string"`
+	PrimaryConnectionStringAlias   types.String `tfsdk:"primary_connection_string_alias"`
+	SecondaryKey                   types.String `tfsdk:"secondary_key"`
+	SecondaryConnectionString      types.String `tfsdk:"secondary_connection_string"`
+	SecondaryConnectionStringAlias types.String `tfsdk:"secondary_connection_string_alias"`
+}
+
+func (e *ServiceBusNamespaceAuthorizationRuleEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "azurerm_servicebus_namespace_authorization_rule"
+}
+
+func (e *ServiceBusNamespaceAuthorizationRuleEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	e.Defaults(req, resp)
+}
+
+func (e *ServiceBusNamespaceAuthorizationRuleEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validate.AuthorizationRuleName(),
+					},
+				},
+			},
+
+			"namespace_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: namespacesauthorizationrule.ValidateNamespaceID,
+					},
+				},
+			},
+
+			"primary_key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"primary_connection_string": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"primary_connection_string_alias": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_connection_string": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_connection_string_alias": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (e *ServiceBusNamespaceAuthorizationRuleEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	client := e.Client.ServiceBus.NamespacesAuthClient
+	ctx, cancel := context.WithTimeout(ctx, time.Minute*5)
+	defer cancel()
+
+	var data ServiceBusNamespaceAuthorizationRuleEphemeralResourceModel
+
+	if ok := e.DecodeOpen(ctx, req, resp, &data); !ok {
+		return
+	}
+
+	namespaceId, err := namespacesauthorizationrule.ParseNamespaceID(data.NamespaceID.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, "", err)
+		return
+	}
+
+	id := namespacesauthorizationrule.NewAuthorizationRuleID(namespaceId.SubscriptionId, namespaceId.ResourceGroupName, namespaceId.NamespaceName, data.Name.ValueString())
+
+	keys, err := client.NamespacesListKeys(ctx, id)
+	if err != nil {
+		if response.WasNotFound(keys.HttpResponse) {
+			sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("%s was not found", id), err)
+			return
+		}
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("listing keys for %s", id), err)
+		return
+	}
+
+	if model := keys.Model; model != nil {
+		data.PrimaryKey = types.StringValue(pointer.From(model.PrimaryKey))
+		data.PrimaryConnectionString = types.StringValue(pointer.From(model.PrimaryConnectionString))
+		data.PrimaryConnectionStringAlias = types.StringValue(pointer.From(model.AliasPrimaryConnectionString))
+		data.SecondaryKey = types.StringValue(pointer.From(model.SecondaryKey))
+		data.SecondaryConnectionString = types.StringValue(pointer.From(model.SecondaryConnectionString))
+		data.SecondaryConnectionStringAlias = types.StringValue(pointer.From(model.AliasSecondaryConnectionString))
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,62 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package servicebus_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type ServiceBusTopicAuthorizationRuleEphemeral struct{}
+
+func TestAccEphemeralServiceBusTopicAuthorizationRule_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "ephemeral.azurerm_servicebus_topic_authorization_rule", "test")
+	r := ServiceBusTopicAuthorizationRuleEphemeral{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.10.0-rc1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		ProtoV6ProviderFactories: framework.ProtoV6ProviderFactoriesInit(context.Background(), "azurerm", "echo"),
+		Steps: []resource.TestStep{
+			{
+				Config: r.basic(data),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("primary_key"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("primary_connection_string"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("secondary_key"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("secondary_connection_string"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+func (ServiceBusTopicAuthorizationRuleEphemeral) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+ephemeral "azurerm_servicebus_topic_authorization_rule" "test" {
+  name     = azurerm_servicebus_topic_authorization_rule.test.name
+  topic_id = azurerm_servicebus_topic_authorization_rule.test.topic_id
+}
+
+provider "echo" {
+  data = ephemeral.azurerm_servicebus_topic_authorization_rule.test
+}
+
+resource "echo" "test" {}
+`, ServiceBusTopicAuthorizationRuleResource{}.base(data, true, false, false))
+}
@@ -0,0 +1,234 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/storage"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.EphemeralResource = &StorageAccountBlobContainerSasEphemeralResource{}
+
+func NewStorageAccountBlobContainerSasEphemeralResource() ephemeral.EphemeralResource {
+	return &StorageAccountBlobContainerSasEphemeralResource{}
+}
+
+type StorageAccountBlobContainerSasEphemeralResource struct {
+	sdk.EphemeralResourceMetadata
+}
+
+type StorageAccountBlobContainerSasEphemeralResourceModel struct {
+	ConnectionString   types.String                                                                        `tfsdk:"connection_string"`
+	ContainerName      types.String                                                                        `tfsdk:"container_name"`
+	HttpsOnly          types.Bool                                                                          `tfsdk:"https_only"`
+	IPAddress          types.String                                                                        `tfsdk:"ip_address"`
+	Start              types.String                                                                        `tfsdk:"start"`
+	Expiry             types.String                                                                        `tfsdk:"expiry"`
+	Permissions        typehelpers.ListNestedObjectValueOf[StorageAccountBlobContainerSasPermissionsModel] `tfsdk:"permissions"`
+	CacheControl       types.String                                                                        `tfsdk:"cache_control"`
+	ContentDisposition types.String                                                                        `tfsdk:"content_disposition"`
+	ContentEncoding    types.String                                                                        `tfsdk:"content_encoding"`
+	ContentLanguage    types.String                                                                        `tfsdk:"content_language"`
+	ContentType        types.String                                                                        `tfsdk:"content_type"`
+	Sas                types.String                                                                        `tfsdk:"sas"`
+}
+
+type StorageAccountBlobContainerSasPermissionsModel struct {
+	Read                  types.Bool `tfsdk:"read"`
+	Add                   types.Bool `tfsdk:"add"`
+	Create                types.Bool `tfsdk:"create"`
+	Write                 types.Bool `tfsdk:"write"`
+	Delete                types.Bool `tfsdk:"delete"`
+	DeleteVersion         types.Bool `tfsdk:"delete_version"`
+	List                  types.Bool `tfsdk:"list"`
+	Tags                  types.Bool `tfsdk:"tags"`
+	Find                  types.Bool `tfsdk:"find"`
+	Move                  types.Bool `tfsdk:"move"`
+	Execute               types.Bool `tfsdk:"execute"`
+	Ownership             types.Bool `tfsdk:"ownership"`
+	Permissions           types.Bool `tfsdk:"permissions"`
+	SetImmutabilityPolicy types.Bool `tfsdk:"set_immutability_policy"`
+}
+
+func (e *StorageAccountBlobContainerSasEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "azurerm_storage_account_blob_container_sas"
+}
+
+func (e *StorageAccountBlobContainerSasEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	e.Defaults(req, resp)
+}
+
+func (e *StorageAccountBlobContainerSasEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	permissions := make(map[string]schema.Attribute)
+	for _, name := range []string{"read", "add", "create", "write", "delete", "delete_version", "list", "tags", "find", "move", "execute", "ownership", "permissions", "set_immutability_policy"} {
+		permissions[name] = schema.BoolAttribute{
+			Optional: true,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"connection_string": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.StringIsNotEmpty,
+					},
+				},
+			},
+
+			"container_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.StringIsNotEmpty,
+					},
+				},
+			},
+
+			"https_only": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only permit `https` access. Defaults to `true`.",
+			},
+
+			"ip_address": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: storageValidate.SharedAccessSignatureIP,
+					},
+				},
+			},
+
+			"start": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validate.ISO8601DateTime,
+					},
+				},
+			},
+
+			"expiry": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validate.ISO8601DateTime,
+					},
+				},
+			},
+
+			"cache_control": schema.StringAttribute{
+				Optional: true,
+			},
+
+			"content_disposition": schema.StringAttribute{
+				Optional: true,
+			},
+
+			"content_encoding": schema.StringAttribute{
+				Optional: true,
+			},
+
+			"content_language": schema.StringAttribute{
+				Optional: true,
+			},
+
+			"content_type": schema.StringAttribute{
+				Optional: true,
+			},
+
+			"sas": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"permissions": schema.ListNestedBlock{
+				CustomType: typehelpers.NewListNestedObjectTypeOf[StorageAccountBlobContainerSasPermissionsModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeBetween(1, 1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: permissions,
+				},
+			},
+		},
+	}
+}
+
+func (e *StorageAccountBlobContainerSasEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data StorageAccountBlobContainerSasEphemeralResourceModel
+
+	if ok := e.DecodeOpen(ctx, req, resp, &data); !ok {
+		return
+	}
+
+	permissions, diags := data.Permissions.ToPtr(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permissionsString := ""
+	if permissions != nil {
+		permissionsString = BuildContainerPermissionsString(map[string]interface{}{
+			"read":                    permissions.Read.ValueBool(),
+			"add":                     permissions.Add.ValueBool(),
+			"create":                  permissions.Create.ValueBool(),
+			"write":                   permissions.Write.ValueBool(),
+			"delete":                  permissions.Delete.ValueBool(),
+			"delete_version":          permissions.DeleteVersion.ValueBool(),
+			"list":                    permissions.List.ValueBool(),
+			"tags":                    permissions.Tags.ValueBool(),
+			"find":                    permissions.Find.ValueBool(),
+			"move":                    permissions.Move.ValueBool(),
+			"execute":                 permissions.Execute.ValueBool(),
+			"ownership":               permissions.Ownership.ValueBool(),
+			"permissions":             permissions.Permissions.ValueBool(),
+			"set_immutability_policy": permissions.SetImmutabilityPolicy.ValueBool(),
+		})
+	}
+
+	kvp, err := storage.ParseAccountSASConnectionString(data.ConnectionString.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, "parsing `connection_string`", err)
+		return
+	}
+
+	accountName := kvp[connStringAccountNameKey]
+	accountKey := kvp[connStringAccountKeyKey]
+
+	signedProtocol := "https"
+	if !data.HttpsOnly.IsNull() && !data.HttpsOnly.ValueBool() {
+		signedProtocol = "https,http"
+	}
+	signedIdentifier := ""
+	signedSnapshotTime := ""
+
+	sasToken, err := storage.ComputeContainerSASToken(permissionsString, data.Start.ValueString(), data.Expiry.ValueString(), accountName, accountKey,
+		data.ContainerName.ValueString(), signedIdentifier, data.IPAddress.ValueString(), signedProtocol, signedSnapshotTime, data.CacheControl.ValueString(),
+		data.ContentDisposition.ValueString(), data.ContentEncoding.ValueString(), data.ContentLanguage.ValueString(), data.ContentType.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, "computing Blob Container SAS Token", err)
+		return
+	}
+
+	data.Sas = types.StringValue(sasToken)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,158 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2025-08-01/storageaccounts"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+var _ sdk.EphemeralResource = &StorageAccountKeysEphemeralResource{}
+
+func NewStorageAccountKeysEphemeralResource() ephemeral.EphemeralResource {
+	return &StorageAccountKeysEphemeralResource{}
+}
+
+type StorageAccountKeysEphemeralResource struct {
+	sdk.EphemeralResourceMetadata
+}
+
+type StorageAccountKeysEphemeralResourceModel struct {
+	StorageAccountId              types.String `tfsdk:"storage_account_id"`
+	PrimaryAccessKey              types.String `tfsdk:"primary_access_key"`
+	SecondaryAccessKey            types.String `tfsdk:"secondary_access_key"`
+	PrimaryConnectionString       types.String `tfsdk:"primary_connection_string"`
+	SecondaryConnectionString     types.String `tfsdk:"secondary_connection_string"`
+	PrimaryBlobConnectionString   types.String `tfsdk:"primary_blob_connection_string"`
+	SecondaryBlobConnectionString types.String `tfsdk:"secondary_blob_connection_string"`
+}
+
+func (e *StorageAccountKeysEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "azurerm_storage_account_keys"
+}
+
+func (e *StorageAccountKeysEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	e.Defaults(req, resp)
+}
+
+func (e *StorageAccountKeysEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"storage_account_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: commonids.ValidateStorageAccountID,
+					},
+				},
+			},
+
+			"primary_access_key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_access_key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"primary_connection_string": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_connection_string": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"primary_blob_connection_string": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_blob_connection_string": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (e *StorageAccountKeysEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	client := e.Client.Storage.ResourceManager.StorageAccounts
+	ctx, cancel := context.WithTimeout(ctx, time.Minute*5)
+	defer cancel()
+
+	var data StorageAccountKeysEphemeralResourceModel
+
+	if ok := e.DecodeOpen(ctx, req, resp, &data); !ok {
+		return
+	}
+
+	id, err := commonids.ParseStorageAccountID(data.StorageAccountId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, "", err)
+		return
+	}
+
+	storageDomainSuffix, ok := e.Client.Account.Environment.Storage.DomainSuffix()
+	if !ok {
+		sdk.SetResponseErrorDiagnostic(resp, "determining Storage domain suffix", fmt.Errorf("could not determine Storage domain suffix for environment %q", e.Client.Account.Environment.Name))
+		return
+	}
+
+	existing, err := client.GetProperties(ctx, *id, storageaccounts.DefaultGetPropertiesOperationOptions())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("retrieving %s", id), err)
+		return
+	}
+
+	keys, err := client.ListKeys(ctx, *id, storageaccounts.DefaultListKeysOperationOptions())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("listing Keys for %s", id), err)
+		return
+	}
+
+	var primaryEndpoints *storageaccounts.Endpoints
+	var secondaryEndpoints *storageaccounts.Endpoints
+	var routingPreference *storageaccounts.RoutingPreference
+	if model := existing.Model; model != nil && model.Properties != nil {
+		primaryEndpoints = model.Properties.PrimaryEndpoints
+		routingPreference = model.Properties.RoutingPreference
+		secondaryEndpoints = model.Properties.SecondaryEndpoints
+	}
+	endpoints := flattenAccountEndpoints(primaryEndpoints, secondaryEndpoints, routingPreference)
+
+	storageAccountKeys := make([]storageaccounts.StorageAccountKey, 0)
+	if keys.Model != nil && keys.Model.Keys != nil {
+		storageAccountKeys = *keys.Model.Keys
+	}
+	if len(storageAccountKeys) == 0 {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("listing Keys for %s", id), fmt.Errorf("no keys were returned"))
+		return
+	}
+
+	keysAndConnectionStrings := flattenAccountAccessKeysAndConnectionStrings(id.StorageAccountName, *storageDomainSuffix, storageAccountKeys, endpoints)
+
+	data.PrimaryAccessKey = types.StringValue(keysAndConnectionStrings.primaryAccessKey)
+	data.SecondaryAccessKey = types.StringValue(keysAndConnectionStrings.secondaryAccessKey)
+	data.PrimaryConnectionString = types.StringValue(keysAndConnectionStrings.primaryConnectionString)
+	data.SecondaryConnectionString = types.StringValue(keysAndConnectionStrings.secondaryConnectionString)
+	data.PrimaryBlobConnectionString = types.StringValue(keysAndConnectionStrings.primaryBlobConnectionString)
+	data.SecondaryBlobConnectionString = types.StringValue(keysAndConnectionStrings.secondaryBlobConnectionString)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
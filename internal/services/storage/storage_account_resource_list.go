@@ -6,7 +6,6 @@ package storage
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
@@ -68,40 +67,27 @@ func (r StorageAccountListResource) List(ctx context.Context, request list.ListR
 		listResults = resp.Items
 	}
 
-	stream.Results = func(push func(list.ListResult) bool) {
-		// TODO - Do we need to handle limiting the results to ListRequest.Limit?
-		variableTimeout := time.Duration(5*len(listResults)) * time.Minute
-		ctx, cancel := context.WithTimeout(context.Background(), variableTimeout)
-		defer cancel()
-		for _, account := range listResults {
-			result := request.NewListResult(ctx)
-			result.DisplayName = pointer.From(account.Name)
-			id, err := commonids.ParseStorageAccountID(*account.Id)
-			if err != nil {
-				sdk.SetErrorDiagnosticAndPushListResult(result, push, "parsing Storage Account ID", err)
-				return
-			}
-
-			saResource := resourceStorageAccount()
-
-			rd := saResource.Data(&terraform.InstanceState{})
-
-			rd.SetId(id.ID())
-
-			if err := resourceStorageAccountFlatten(ctx, rd, *id, pointer.To(account), metadata.Client, request.IncludeResource); err != nil {
-				sdk.SetErrorDiagnosticAndPushListResult(result, push, "encoding Resource data", err)
-				return
-			}
-
-			sdk.EncodeListResult(ctx, rd, &result)
-			if result.Diagnostics.HasError() {
-				push(result)
-				return
-			}
-
-			if !push(result) {
-				return
-			}
+	stream.Results = sdk.ListResultsConcurrently(ctx, request, metadata, listResults, func(ctx context.Context, account storageaccounts.StorageAccount, push func(list.ListResult) bool) {
+		result := request.NewListResult(ctx)
+		result.DisplayName = pointer.From(account.Name)
+		id, err := commonids.ParseStorageAccountID(*account.Id)
+		if err != nil {
+			sdk.SetErrorDiagnosticAndPushListResult(result, push, "parsing Storage Account ID", err)
+			return
 		}
-	}
+
+		saResource := resourceStorageAccount()
+
+		rd := saResource.Data(&terraform.InstanceState{})
+
+		rd.SetId(id.ID())
+
+		if err := resourceStorageAccountFlatten(ctx, rd, *id, pointer.To(account), metadata.Client, request.IncludeResource); err != nil {
+			sdk.SetErrorDiagnosticAndPushListResult(result, push, "encoding Resource data", err)
+			return
+		}
+
+		sdk.EncodeListResult(ctx, rd, &result)
+		push(result)
+	})
 }
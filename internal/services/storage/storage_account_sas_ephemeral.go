@@ -0,0 +1,334 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/storage"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.EphemeralResource = &StorageAccountSasEphemeralResource{}
+
+func NewStorageAccountSasEphemeralResource() ephemeral.EphemeralResource {
+	return &StorageAccountSasEphemeralResource{}
+}
+
+// StorageAccountSasEphemeralResource computes an ACCOUNT SAS : https://docs.microsoft.com/en-us/rest/api/storageservices/Constructing-an-Account-SAS
+// locally from the Account Key within the Connection String, without persisting the token to the state or plan.
+type StorageAccountSasEphemeralResource struct {
+	sdk.EphemeralResourceMetadata
+}
+
+type StorageAccountSasEphemeralResourceModel struct {
+	ConnectionString types.String                                                             `tfsdk:"connection_string"`
+	HttpsOnly        types.Bool                                                               `tfsdk:"https_only"`
+	IPAddresses      types.String                                                             `tfsdk:"ip_addresses"`
+	SignedVersion    types.String                                                             `tfsdk:"signed_version"`
+	ResourceTypes    typehelpers.ListNestedObjectValueOf[StorageAccountSasResourceTypesModel] `tfsdk:"resource_types"`
+	Services         typehelpers.ListNestedObjectValueOf[StorageAccountSasServicesModel]      `tfsdk:"services"`
+	Start            types.String                                                             `tfsdk:"start"`
+	Expiry           types.String                                                             `tfsdk:"expiry"`
+	Permissions      typehelpers.ListNestedObjectValueOf[StorageAccountSasPermissionsModel]   `tfsdk:"permissions"`
+	Sas              types.String                                                             `tfsdk:"sas"`
+}
+
+type StorageAccountSasResourceTypesModel struct {
+	Service   types.Bool `tfsdk:"service"`
+	Container types.Bool `tfsdk:"container"`
+	Object    types.Bool `tfsdk:"object"`
+}
+
+type StorageAccountSasServicesModel struct {
+	Blob  types.Bool `tfsdk:"blob"`
+	Queue types.Bool `tfsdk:"queue"`
+	Table types.Bool `tfsdk:"table"`
+	File  types.Bool `tfsdk:"file"`
+}
+
+type StorageAccountSasPermissionsModel struct {
+	Read    types.Bool `tfsdk:"read"`
+	Write   types.Bool `tfsdk:"write"`
+	Delete  types.Bool `tfsdk:"delete"`
+	List    types.Bool `tfsdk:"list"`
+	Add     types.Bool `tfsdk:"add"`
+	Create  types.Bool `tfsdk:"create"`
+	Update  types.Bool `tfsdk:"update"`
+	Process types.Bool `tfsdk:"process"`
+	Tag     types.Bool `tfsdk:"tag"`
+	Filter  types.Bool `tfsdk:"filter"`
+}
+
+func (e *StorageAccountSasEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "azurerm_storage_account_sas"
+}
+
+func (e *StorageAccountSasEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	e.Defaults(req, resp)
+}
+
+func (e *StorageAccountSasEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"connection_string": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.StringIsNotEmpty,
+					},
+				},
+			},
+
+			"https_only": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only permit `https` access. Defaults to `true`.",
+			},
+
+			"ip_addresses": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.Any(
+							validation.IsIPv4Address,
+							validation.IsIPv4Range,
+						),
+					},
+				},
+			},
+
+			"signed_version": schema.StringAttribute{
+				Optional:    true,
+				Description: "Specifies the signed storage service version to use. Defaults to `2022-11-02`.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.StringIsNotEmpty,
+					},
+				},
+			},
+
+			// Always in UTC and must be ISO-8601 format
+			"start": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validate.ISO8601DateTime,
+					},
+				},
+			},
+
+			// Always in UTC and must be ISO-8601 format
+			"expiry": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validate.ISO8601DateTime,
+					},
+				},
+			},
+
+			"sas": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"resource_types": schema.ListNestedBlock{
+				CustomType: typehelpers.NewListNestedObjectTypeOf[StorageAccountSasResourceTypesModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeBetween(1, 1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"service": schema.BoolAttribute{
+							Required: true,
+						},
+
+						"container": schema.BoolAttribute{
+							Required: true,
+						},
+
+						"object": schema.BoolAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"services": schema.ListNestedBlock{
+				CustomType: typehelpers.NewListNestedObjectTypeOf[StorageAccountSasServicesModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeBetween(1, 1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"blob": schema.BoolAttribute{
+							Required: true,
+						},
+
+						"queue": schema.BoolAttribute{
+							Required: true,
+						},
+
+						"table": schema.BoolAttribute{
+							Required: true,
+						},
+
+						"file": schema.BoolAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"permissions": schema.ListNestedBlock{
+				CustomType: typehelpers.NewListNestedObjectTypeOf[StorageAccountSasPermissionsModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeBetween(1, 1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"read": schema.BoolAttribute{
+							Optional: true,
+						},
+
+						"write": schema.BoolAttribute{
+							Optional: true,
+						},
+
+						"delete": schema.BoolAttribute{
+							Optional: true,
+						},
+
+						"list": schema.BoolAttribute{
+							Optional: true,
+						},
+
+						"add": schema.BoolAttribute{
+							Optional: true,
+						},
+
+						"create": schema.BoolAttribute{
+							Optional: true,
+						},
+
+						"update": schema.BoolAttribute{
+							Optional: true,
+						},
+
+						"process": schema.BoolAttribute{
+							Optional: true,
+						},
+
+						"tag": schema.BoolAttribute{
+							Optional: true,
+						},
+
+						"filter": schema.BoolAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (e *StorageAccountSasEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data StorageAccountSasEphemeralResourceModel
+
+	if ok := e.DecodeOpen(ctx, req, resp, &data); !ok {
+		return
+	}
+
+	resourceTypes, diags := data.ResourceTypes.ToPtr(ctx)
+	resp.Diagnostics.Append(diags...)
+	services, diags := data.Services.ToPtr(ctx)
+	resp.Diagnostics.Append(diags...)
+	permissions, diags := data.Permissions.ToPtr(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceTypesString := ""
+	if resourceTypes != nil {
+		resourceTypesString = BuildResourceTypesString(map[string]interface{}{
+			"service":   resourceTypes.Service.ValueBool(),
+			"container": resourceTypes.Container.ValueBool(),
+			"object":    resourceTypes.Object.ValueBool(),
+		})
+	}
+
+	servicesString := ""
+	if services != nil {
+		servicesString = BuildServicesString(map[string]interface{}{
+			"blob":  services.Blob.ValueBool(),
+			"queue": services.Queue.ValueBool(),
+			"table": services.Table.ValueBool(),
+			"file":  services.File.ValueBool(),
+		})
+	}
+
+	permissionsString := ""
+	if permissions != nil {
+		permissionsString = BuildPermissionsString(map[string]interface{}{
+			"read":    permissions.Read.ValueBool(),
+			"write":   permissions.Write.ValueBool(),
+			"delete":  permissions.Delete.ValueBool(),
+			"list":    permissions.List.ValueBool(),
+			"add":     permissions.Add.ValueBool(),
+			"create":  permissions.Create.ValueBool(),
+			"update":  permissions.Update.ValueBool(),
+			"process": permissions.Process.ValueBool(),
+			"tag":     permissions.Tag.ValueBool(),
+			"filter":  permissions.Filter.ValueBool(),
+		})
+	}
+
+	kvp, err := storage.ParseAccountSASConnectionString(data.ConnectionString.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, "parsing `connection_string`", err)
+		return
+	}
+
+	accountName := kvp[connStringAccountNameKey]
+	accountKey := kvp[connStringAccountKeyKey]
+
+	signedProtocol := "https"
+	if !data.HttpsOnly.IsNull() && !data.HttpsOnly.ValueBool() {
+		signedProtocol = "https,http"
+	}
+
+	signedVersion := sasSignedVersion
+	if v := data.SignedVersion.ValueString(); v != "" {
+		signedVersion = v
+	}
+
+	// TODO: implement support for signedEncryptionScope
+	signedEncryptionScope := ""
+
+	sasToken, err := storage.ComputeAccountSASToken(accountName, accountKey, permissionsString, servicesString, resourceTypesString,
+		data.Start.ValueString(), data.Expiry.ValueString(), signedProtocol, data.IPAddresses.ValueString(), signedVersion, signedEncryptionScope)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, "computing Account SAS Token", err)
+		return
+	}
+
+	data.Sas = types.StringValue(sasToken)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -68,34 +68,25 @@ func (r StorageSyncListResource) List(ctx context.Context, request list.ListRequ
 		}
 	}
 
-	stream.Results = func(push func(list.ListResult) bool) {
-		for _, item := range results {
-			result := request.NewListResult(ctx)
-			result.DisplayName = pointer.From(item.Name)
-
-			id, err := storagesyncservicesresource.ParseStorageSyncServiceIDInsensitively(pointer.From(item.Id))
-			if err != nil {
-				sdk.SetErrorDiagnosticAndPushListResult(result, push, "parsing Storage Sync Service ID", err)
-				return
-			}
-
-			rd := resourceStorageSync().Data(&terraform.InstanceState{})
-			rd.SetId(id.ID())
-
-			if err := resourceStorageSyncFlatten(ctx, rd, id, &item, metadata.Client.Storage.SyncRegisteredServerClient, request.IncludeResource); err != nil {
-				sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("encoding `%s` resource data", storageSyncResourceName), err)
-				return
-			}
-
-			sdk.EncodeListResult(ctx, rd, &result)
-			if result.Diagnostics.HasError() {
-				push(result)
-				return
-			}
-
-			if !push(result) {
-				return
-			}
+	stream.Results = sdk.ListResultsConcurrently(ctx, request, metadata, results, func(ctx context.Context, item storagesyncservicesresource.StorageSyncService, push func(list.ListResult) bool) {
+		result := request.NewListResult(ctx)
+		result.DisplayName = pointer.From(item.Name)
+
+		id, err := storagesyncservicesresource.ParseStorageSyncServiceIDInsensitively(pointer.From(item.Id))
+		if err != nil {
+			sdk.SetErrorDiagnosticAndPushListResult(result, push, "parsing Storage Sync Service ID", err)
+			return
 		}
-	}
+
+		rd := resourceStorageSync().Data(&terraform.InstanceState{})
+		rd.SetId(id.ID())
+
+		if err := resourceStorageSyncFlatten(ctx, rd, id, &item, metadata.Client.Storage.SyncRegisteredServerClient, request.IncludeResource); err != nil {
+			sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("encoding `%s` resource data", storageSyncResourceName), err)
+			return
+		}
+
+		sdk.EncodeListResult(ctx, rd, &result)
+		push(result)
+	})
 }
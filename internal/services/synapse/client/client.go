@@ -9,7 +9,10 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/synapse/mgmt/v2.0/synapse" // nolint: staticcheck
 	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-sdk/data-plane/synapse/2021-06-01-preview/activityruns"
 	"github.com/hashicorp/go-azure-sdk/data-plane/synapse/2021-06-01-preview/managedprivateendpoints"
+	"github.com/hashicorp/go-azure-sdk/data-plane/synapse/2021-06-01-preview/pipelineruns"
+	"github.com/hashicorp/go-azure-sdk/data-plane/synapse/2021-06-01-preview/pipelines"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/synapse/2021-06-01/workspaces"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	accesscontrol "github.com/jackofallops/kermit/sdk/synapse/2020-08-01-preview/synapse"
@@ -21,7 +24,10 @@ type Client struct {
 	WorkspacesClient *workspaces.WorkspacesClient
 
 	// Data Plane
+	ActivityRunsClient            *activityruns.ActivityrunsClient
 	ManagedPrivateEndpointsClient *managedprivateendpoints.ManagedPrivateEndpointsClient
+	PipelineRunsClient            *pipelineruns.PipelineRunsClient
+	PipelinesClient               *pipelines.PipelinesClient
 
 	// TODO: Migrate to go-azure-sdk
 	FirewallRulesClient                               *synapse.IPFirewallRulesClient
@@ -60,12 +66,30 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	o.Configure(workspacesClient.Client, o.Authorizers.ResourceManager)
 
 	// Data Plane
+	activityRunsClient, err := activityruns.NewActivityrunsClientUnconfigured()
+	if err != nil {
+		return nil, fmt.Errorf("building Activity Runs Client: %+v", err)
+	}
+	o.Configure(activityRunsClient.Client, o.Authorizers.Synapse)
+
 	managedPrivateEndpointsClient, err := managedprivateendpoints.NewManagedPrivateEndpointsClientUnconfigured()
 	if err != nil {
 		return nil, fmt.Errorf("building Managed Private Endpoints Client: %+v", err)
 	}
 	o.Configure(managedPrivateEndpointsClient.Client, o.Authorizers.Synapse)
 
+	pipelineRunsClient, err := pipelineruns.NewPipelineRunsClientUnconfigured()
+	if err != nil {
+		return nil, fmt.Errorf("building Pipeline Runs Client: %+v", err)
+	}
+	o.Configure(pipelineRunsClient.Client, o.Authorizers.Synapse)
+
+	pipelinesClient, err := pipelines.NewPipelinesClientUnconfigured()
+	if err != nil {
+		return nil, fmt.Errorf("building Pipelines Client: %+v", err)
+	}
+	o.Configure(pipelinesClient.Client, o.Authorizers.Synapse)
+
 	// TODO: migrate to go-azure-sdk
 	firewallRuleClient := synapse.NewIPFirewallRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&firewallRuleClient.Client, o.ResourceManagerAuthorizer)
@@ -142,7 +166,10 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		WorkspacesClient: workspacesClient,
 
 		// Data Plane
+		ActivityRunsClient:            activityRunsClient,
 		ManagedPrivateEndpointsClient: managedPrivateEndpointsClient,
+		PipelineRunsClient:            pipelineRunsClient,
+		PipelinesClient:               pipelinesClient,
 
 		// TODO: Migrate to go-azure-sdk
 		FirewallRulesClient:                               &firewallRuleClient,
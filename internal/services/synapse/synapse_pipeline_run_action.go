@@ -0,0 +1,282 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package synapse
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/data-plane/synapse/2021-06-01-preview/activityruns"
+	"github.com/hashicorp/go-azure-sdk/data-plane/synapse/2021-06-01-preview/pipelineruns"
+	"github.com/hashicorp/go-azure-sdk/data-plane/synapse/2021-06-01-preview/pipelines"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type SynapsePipelineRunAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &SynapsePipelineRunAction{}
+
+func newSynapsePipelineRunAction() action.Action {
+	return &SynapsePipelineRunAction{}
+}
+
+type SynapsePipelineRunActionModel struct {
+	SynapseWorkspaceId types.String `tfsdk:"synapse_workspace_id"`
+	PipelineName       types.String `tfsdk:"pipeline_name"`
+	Parameters         types.Map    `tfsdk:"parameters"`
+	WaitForCompletion  types.Bool   `tfsdk:"wait_for_completion"`
+	Timeout            types.String `tfsdk:"timeout"`
+}
+
+func (s *SynapsePipelineRunAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"synapse_workspace_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the Synapse Workspace containing the Pipeline.",
+				MarkdownDescription: "The ID of the Synapse Workspace containing the Pipeline.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validate.WorkspaceID,
+					},
+				},
+			},
+
+			"pipeline_name": schema.StringAttribute{
+				Required:            true,
+				Description:         "The name of the Synapse Pipeline to run.",
+				MarkdownDescription: "The name of the Synapse Pipeline to run.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.StringIsNotEmpty,
+					},
+				},
+			},
+
+			"parameters": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Description:         "A map of parameters to pass to the Pipeline run.",
+				MarkdownDescription: "A map of parameters to pass to the Pipeline run.",
+			},
+
+			"wait_for_completion": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Should the action wait for the Pipeline run to complete, and fail if the run does not succeed? Defaults to `true`.",
+				MarkdownDescription: "Should the action wait for the Pipeline run to complete, and fail if the run does not succeed? Defaults to `true`.",
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the action to complete. Defaults to `60m`.",
+				MarkdownDescription: "Timeout duration for the action to complete. Defaults to `60m`.",
+			},
+		},
+	}
+}
+
+func (s *SynapsePipelineRunAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_synapse_pipeline_run"
+}
+
+func (s *SynapsePipelineRunAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	model := SynapsePipelineRunActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctxTimeout := 60 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+
+		ctxTimeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	workspaceId, err := parse.WorkspaceID(model.SynapseWorkspaceId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	baseURI, err := NewSynapseWorkspaceBaseURI(s.Client.Account.Environment, workspaceId.Name)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", err)
+		return
+	}
+
+	id := pipelines.NewPipelineID(baseURI, model.PipelineName.ValueString())
+	client := s.Client.Synapse.PipelinesClient.Clone(id.BaseURI)
+	runsClient := s.Client.Synapse.PipelineRunsClient.Clone(id.BaseURI)
+
+	pipeline := fmt.Sprintf("Pipeline %q (%s)", id.PipelineName, workspaceId)
+
+	parameters := make(map[string]string)
+	if !model.Parameters.IsNull() && !model.Parameters.IsUnknown() {
+		response.Diagnostics.Append(model.Parameters.ElementsAs(ctx, &parameters, false)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	input := make(map[string]interface{}, len(parameters))
+	for k, v := range parameters {
+		input[k] = v
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("starting a run of %s", pipeline),
+	})
+
+	resp, err := client.PipelineCreatePipelineRun(ctx, id, input, pipelines.DefaultPipelineCreatePipelineRunOperationOptions())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("starting a run of %s: %+v", pipeline, err))
+		return
+	}
+	if resp.Model == nil || resp.Model.RunId == "" {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("starting a run of %s: the run ID was nil", pipeline))
+		return
+	}
+	runId := resp.Model.RunId
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("started run %q of %s", runId, pipeline),
+	})
+
+	if !model.WaitForCompletion.IsNull() && !model.WaitForCompletion.ValueBool() {
+		return
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		sdk.SetResponseErrorDiagnostic(response, "running action", "internal-error: context had no deadline")
+		return
+	}
+
+	lastStatus := ""
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{"Queued", "InProgress", "Canceling"},
+		Target:  []string{"Succeeded", "Failed", "Cancelled"},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := runsClient.PipelineRunGetPipelineRun(ctx, pipelineruns.NewPipelineRunID(id.BaseURI, runId))
+			if err != nil {
+				return nil, "", fmt.Errorf("retrieving run %q of %s: %+v", runId, pipeline, err)
+			}
+			if resp.Model == nil {
+				return nil, "", fmt.Errorf("retrieving run %q of %s: `model` was nil", runId, pipeline)
+			}
+			run := *resp.Model
+
+			status := pointer.From(run.Status)
+			if status != lastStatus {
+				response.SendProgress(action.InvokeProgressEvent{
+					Message: fmt.Sprintf("run %q of %s is %s", runId, pipeline, status),
+				})
+				lastStatus = status
+			}
+
+			return run, status, nil
+		},
+		MinTimeout:   15 * time.Second,
+		PollInterval: 15 * time.Second,
+		Timeout:      time.Until(deadline),
+	}
+
+	result, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("waiting for run %q of %s to complete: %+v", runId, pipeline, err))
+		return
+	}
+
+	run := result.(pipelineruns.PipelineRun)
+	if status := pointer.From(run.Status); status != "Succeeded" {
+		response.Diagnostics.AddError("running action", fmt.Sprintf("run %q of %s completed with the status %q: %s", runId, pipeline, status, pointer.From(run.Message)))
+
+		activityErrors, err := s.failedActivityErrors(ctx, activityruns.NewPipelinePipelineRunID(id.BaseURI, id.PipelineName, runId), run)
+		if err != nil {
+			response.Diagnostics.AddWarning("retrieving activity errors", fmt.Sprintf("querying the activity runs of run %q of %s: %+v", runId, pipeline, err))
+			return
+		}
+
+		for _, v := range activityErrors {
+			response.Diagnostics.AddError(fmt.Sprintf("activity failed in run %q", runId), v)
+		}
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("run %q of %s completed successfully", runId, pipeline),
+	})
+}
+
+func (s *SynapsePipelineRunAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	s.Defaults(ctx, request, response)
+}
+
+// failedActivityErrors returns the errors of each of the failed Activities within the Pipeline run
+func (s *SynapsePipelineRunAction) failedActivityErrors(ctx context.Context, id activityruns.PipelinePipelineRunId, run pipelineruns.PipelineRun) ([]string, error) {
+	client := s.Client.Synapse.ActivityRunsClient.Clone(id.BaseURI)
+
+	// the Activity runs must be queried within a time window, which is the duration of the Pipeline run
+	updatedAfter := time.Now().Add(-24 * time.Hour)
+	if runStart, err := run.GetRunStartAsTime(); err == nil && runStart != nil {
+		updatedAfter = runStart.Add(-time.Minute)
+	}
+
+	filter := activityruns.RunFilterParameters{
+		Filters: &[]activityruns.RunQueryFilter{
+			{
+				Operand:  activityruns.RunQueryFilterOperandStatus,
+				Operator: activityruns.RunQueryFilterOperatorEquals,
+				Values:   []string{"Failed"},
+			},
+		},
+	}
+	filter.SetLastUpdatedAfterAsTime(updatedAfter)
+	filter.SetLastUpdatedBeforeAsTime(time.Now().Add(time.Minute))
+
+	activityErrors := make([]string, 0)
+	for {
+		resp, err := client.PipelineRunQueryActivityRuns(ctx, id, filter)
+		if err != nil {
+			return nil, err
+		}
+		if resp.Model == nil {
+			break
+		}
+
+		for _, activity := range resp.Model.Value {
+			activityErrors = append(activityErrors, fmt.Sprintf("%s (%s): %s", pointer.From(activity.ActivityName), pointer.From(activity.ActivityType), azure.FlattenActivityRunError(activity.Error)))
+		}
+
+		if resp.Model.ContinuationToken == nil || *resp.Model.ContinuationToken == "" {
+			break
+		}
+		filter.ContinuationToken = resp.Model.ContinuationToken
+	}
+
+	return activityErrors, nil
+}
@@ -0,0 +1,67 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package synapse_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type SynapsePipelineRunAction struct{}
+
+// Synapse Pipelines can't be managed by the Provider, so this test ensures a run of a Pipeline which doesn't exist is
+// surfaced as an error
+func TestAccSynapsePipelineRunAction_pipelineNotFound(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_pipeline_run", "test")
+	a := SynapsePipelineRunAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: LinkedServiceResource{}.template(data),
+			},
+			{
+				Config:      a.basic(data),
+				ExpectError: regexp.MustCompile("starting a run of"),
+			},
+		},
+	})
+}
+
+func (SynapsePipelineRunAction) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "terraform_data" "trigger" {
+  input = azurerm_synapse_firewall_rule.test.id
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_synapse_pipeline_run.test]
+    }
+  }
+}
+
+action "azurerm_synapse_pipeline_run" "test" {
+  config {
+    synapse_workspace_id = azurerm_synapse_workspace.test.id
+    pipeline_name        = "acctest%[2]d"
+    parameters = {
+      "environment" = "test"
+    }
+  }
+}
+`, LinkedServiceResource{}.template(data), data.RandomInteger)
+}
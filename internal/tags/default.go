@@ -0,0 +1,44 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+// MergeDefault returns the tags which should be assigned to a Resource, by combining the default tags defined
+// in the Provider block with the tags defined on the Resource - where the tags defined on the Resource take
+// precedence over a default tag using the same key.
+func MergeDefault(defaultTags map[string]string, tagsMap map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{}, len(defaultTags)+len(tagsMap))
+
+	for k, v := range defaultTags {
+		output[k] = v
+	}
+
+	for k, v := range tagsMap {
+		// Validate should have ignored this error already
+		value, _ := TagValueToString(v)
+		output[k] = value
+	}
+
+	return output
+}
+
+// RemoveDefault returns the tags which should be exposed in the `tags` field of a Resource, by removing any
+// default tags defined in the Provider block from the tags assigned to the Resource. Default tags are only
+// removed when the value matches the default value and the key hasn't been explicitly configured on the Resource.
+func RemoveDefault(defaultTags map[string]string, tagsMap map[string]interface{}, configured map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{}, len(tagsMap))
+
+	for k, v := range tagsMap {
+		value, _ := TagValueToString(v)
+
+		if defaultValue, isDefault := defaultTags[k]; isDefault && defaultValue == value {
+			if _, isConfigured := configured[k]; !isConfigured {
+				continue
+			}
+		}
+
+		output[k] = value
+	}
+
+	return output
+}
@@ -0,0 +1,138 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMergeDefault(t *testing.T) {
+	testData := []struct {
+		Name        string
+		DefaultTags map[string]string
+		Input       map[string]interface{}
+		Expected    map[string]interface{}
+	}{
+		{
+			Name:        "Empty",
+			DefaultTags: map[string]string{},
+			Input:       map[string]interface{}{},
+			Expected:    map[string]interface{}{},
+		},
+		{
+			Name: "Only Default Tags",
+			DefaultTags: map[string]string{
+				"cost-center": "1234",
+			},
+			Input: map[string]interface{}{},
+			Expected: map[string]interface{}{
+				"cost-center": "1234",
+			},
+		},
+		{
+			Name:        "Only Resource Tags",
+			DefaultTags: nil,
+			Input: map[string]interface{}{
+				"hello": "there",
+				"euros": 3,
+			},
+			Expected: map[string]interface{}{
+				"hello": "there",
+				"euros": "3",
+			},
+		},
+		{
+			Name: "Resource Tags Take Precedence",
+			DefaultTags: map[string]string{
+				"cost-center": "1234",
+				"owner":       "platform",
+			},
+			Input: map[string]interface{}{
+				"owner": "networking",
+				"hello": "there",
+			},
+			Expected: map[string]interface{}{
+				"cost-center": "1234",
+				"owner":       "networking",
+				"hello":       "there",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Test %q", v.Name)
+
+		actual := MergeDefault(v.DefaultTags, v.Input)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestRemoveDefault(t *testing.T) {
+	testData := []struct {
+		Name        string
+		DefaultTags map[string]string
+		Input       map[string]interface{}
+		Configured  map[string]interface{}
+		Expected    map[string]interface{}
+	}{
+		{
+			Name:        "Empty",
+			DefaultTags: map[string]string{},
+			Input:       map[string]interface{}{},
+			Expected:    map[string]interface{}{},
+		},
+		{
+			Name: "Default Tag Removed",
+			DefaultTags: map[string]string{
+				"cost-center": "1234",
+			},
+			Input: map[string]interface{}{
+				"cost-center": "1234",
+				"hello":       "there",
+			},
+			Expected: map[string]interface{}{
+				"hello": "there",
+			},
+		},
+		{
+			Name: "Default Tag With Different Value Retained",
+			DefaultTags: map[string]string{
+				"cost-center": "1234",
+			},
+			Input: map[string]interface{}{
+				"cost-center": "5678",
+			},
+			Expected: map[string]interface{}{
+				"cost-center": "5678",
+			},
+		},
+		{
+			Name: "Configured Default Tag Retained",
+			DefaultTags: map[string]string{
+				"cost-center": "1234",
+			},
+			Input: map[string]interface{}{
+				"cost-center": "1234",
+			},
+			Configured: map[string]interface{}{
+				"cost-center": "1234",
+			},
+			Expected: map[string]interface{}{
+				"cost-center": "1234",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Test %q", v.Name)
+
+		actual := RemoveDefault(v.DefaultTags, v.Input, v.Configured)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import "strings"
+
+// IgnoreConfig defines the tags which are managed outside of Terraform (for example by Azure Policy), which should
+// be ignored when flattening the tags assigned to a Resource.
+type IgnoreConfig struct {
+	// Keys is a list of tag keys which should be ignored, compared case-insensitively.
+	Keys []string
+
+	// KeyPrefixes is a list of tag key prefixes which should be ignored, compared case-insensitively.
+	KeyPrefixes []string
+}
+
+// IsEmpty returns whether no tags are being ignored.
+func (c IgnoreConfig) IsEmpty() bool {
+	return len(c.Keys) == 0 && len(c.KeyPrefixes) == 0
+}
+
+// Remove returns the tags within tagsMap whose keys aren't ignored.
+func (c IgnoreConfig) Remove(tagsMap map[string]interface{}) map[string]interface{} {
+	if c.IsEmpty() {
+		return tagsMap
+	}
+
+	input := make(map[string]string, len(tagsMap))
+	for k, v := range tagsMap {
+		// Validate should have ignored this error already
+		value, _ := TagValueToString(v)
+		input[k] = value
+	}
+
+	output := make(map[string]interface{}, len(tagsMap))
+	for k, v := range *Filter(&input, c.Keys...) {
+		if !c.hasIgnoredPrefix(k) {
+			output[k] = v
+		}
+	}
+
+	return output
+}
+
+// Ignored returns the tags within tagsMap whose keys are ignored.
+func (c IgnoreConfig) Ignored(tagsMap map[string]string) map[string]string {
+	output := make(map[string]string)
+	if c.IsEmpty() {
+		return output
+	}
+
+	retained := Filter(&tagsMap, c.Keys...)
+	for k, v := range tagsMap {
+		if _, ok := (*retained)[k]; !ok || c.hasIgnoredPrefix(k) {
+			output[k] = v
+		}
+	}
+
+	return output
+}
+
+func (c IgnoreConfig) hasIgnoredPrefix(key string) bool {
+	for _, prefix := range c.KeyPrefixes {
+		if prefix != "" && strings.HasPrefix(strings.ToLower(key), strings.ToLower(prefix)) {
+			return true
+		}
+	}
+
+	return false
+}
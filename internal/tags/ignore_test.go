@@ -0,0 +1,104 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIgnoreConfigRemove(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Config   IgnoreConfig
+		Input    map[string]interface{}
+		Expected map[string]interface{}
+	}{
+		{
+			Name:   "Empty Config",
+			Config: IgnoreConfig{},
+			Input: map[string]interface{}{
+				"hello": "there",
+			},
+			Expected: map[string]interface{}{
+				"hello": "there",
+			},
+		},
+		{
+			Name: "Ignored Keys",
+			Config: IgnoreConfig{
+				Keys: []string{"CreatedOnDate"},
+			},
+			Input: map[string]interface{}{
+				"createdondate": "2025-01-01",
+				"hello":         "there",
+			},
+			Expected: map[string]interface{}{
+				"hello": "there",
+			},
+		},
+		{
+			Name: "Ignored Key Prefixes",
+			Config: IgnoreConfig{
+				KeyPrefixes: []string{"hidden-link:"},
+			},
+			Input: map[string]interface{}{
+				"Hidden-Link:/subscriptions/123": "Resource",
+				"hidden-title":                   "Example",
+				"hello":                          "there",
+			},
+			Expected: map[string]interface{}{
+				"hidden-title": "Example",
+				"hello":        "there",
+			},
+		},
+		{
+			Name: "Ignored Keys and Key Prefixes",
+			Config: IgnoreConfig{
+				Keys:        []string{"CreatedOnDate"},
+				KeyPrefixes: []string{"hidden-"},
+			},
+			Input: map[string]interface{}{
+				"CreatedOnDate": "2025-01-01",
+				"hidden-title":  "Example",
+				"hello":         "there",
+			},
+			Expected: map[string]interface{}{
+				"hello": "there",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Test %q", v.Name)
+
+		actual := v.Config.Remove(v.Input)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestIgnoreConfigIgnored(t *testing.T) {
+	config := IgnoreConfig{
+		Keys:        []string{"CreatedOnDate"},
+		KeyPrefixes: []string{"hidden-link:"},
+	}
+
+	input := map[string]string{
+		"createdOnDate":                  "2025-01-01",
+		"hidden-link:/subscriptions/123": "Resource",
+		"hello":                          "there",
+	}
+
+	expected := map[string]string{
+		"createdOnDate":                  "2025-01-01",
+		"hidden-link:/subscriptions/123": "Resource",
+	}
+
+	actual := config.Ignored(input)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}